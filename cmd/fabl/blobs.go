@@ -0,0 +1,148 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"api.fabl.app/internal/sql"
+	"github.com/jmoiron/sqlx"
+	"github.com/urfave/cli/v2"
+)
+
+var blobsCommand = &cli.Command{
+	Name:  "blobs",
+	Usage: "Manages the storage of item data",
+
+	Subcommands: []*cli.Command{
+		{
+			Name:   "compress",
+			Usage:  "Compresses the stored item data not compressed yet",
+			Action: blobsCompressAction,
+			Flags: []cli.Flag{
+				dbDSNFlag,
+				blobStoreFlag,
+				&cli.BoolFlag{
+					Name:  "decode",
+					Usage: "decompress all item data instead, e.g. before reverting the migration adding compression",
+				},
+				&cli.IntFlag{
+					Name:  "batch-size",
+					Usage: "number of blobs compressed per transaction",
+					Value: 100,
+				},
+			},
+		},
+		{
+			Name:   "migrate",
+			Usage:  "Moves the item data kept in the database to the blob store",
+			Action: blobsMigrateAction,
+			Flags: []cli.Flag{
+				dbDSNFlag,
+				blobStoreFlag,
+				&cli.BoolFlag{
+					Name:  "to-database",
+					Usage: "move the item data kept in the blob store back to the database instead",
+				},
+				&cli.IntFlag{
+					Name:  "batch-size",
+					Usage: "number of blobs moved per transaction",
+					Value: 100,
+				},
+			},
+		},
+		{
+			Name:   "stats",
+			Usage:  "Reports the size of the stored item data and the space saved by compression",
+			Action: blobsStatsAction,
+			Flags: []cli.Flag{
+				dbDSNFlag,
+			},
+		},
+	},
+}
+
+func blobsCompressAction(c *cli.Context) error {
+	blobs, err := openBlobStore(c)
+	if err != nil {
+		return err
+	}
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	codec := "zlib"
+	if c.Bool("decode") {
+		codec = ""
+	}
+	n, err := sql.RecodeData(c.Context, db, blobs, codec, c.Int("batch-size"))
+	if codec == "" {
+		fmt.Printf("decompressed %d blobs\n", n)
+	} else {
+		fmt.Printf("compressed %d blobs\n", n)
+	}
+	if err != nil {
+		return err
+	}
+	return printDataStats(c, db)
+}
+
+func blobsMigrateAction(c *cli.Context) error {
+	blobs, err := openBlobStore(c)
+	if err != nil {
+		return err
+	}
+	if blobs == nil {
+		return errors.New("missing blob store")
+	}
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if c.Bool("to-database") {
+		n, err := sql.MoveDataToDatabase(c.Context, db, blobs, c.Int("batch-size"))
+		fmt.Printf("moved %d blobs to the database\n", n)
+		return err
+	}
+	n, err := sql.MoveDataToBlobStore(c.Context, db, blobs, c.Int("batch-size"))
+	fmt.Printf("moved %d blobs to the blob store\n", n)
+	return err
+}
+
+func blobsStatsAction(c *cli.Context) error {
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	return printDataStats(c, db)
+}
+
+func printDataStats(c *cli.Context, db *sqlx.DB) error {
+	stats, err := sql.GetDataStats(c.Context, db)
+	if err != nil {
+		return err
+	}
+	var total int
+	for _, n := range stats.Blobs {
+		total += n
+	}
+	fmt.Printf("%d blobs, %d in the blob store\n", total, stats.External)
+	for codec, n := range stats.Blobs {
+		if codec == "" {
+			codec = "uncompressed"
+		}
+		fmt.Printf("  %-12s %d\n", codec, n)
+	}
+	saved := stats.Size - stats.StoredSize
+	var ratio float64
+	if stats.Size > 0 {
+		ratio = float64(saved) / float64(stats.Size) * 100
+	}
+	fmt.Printf("%d bytes of item data stored in %d bytes, %d bytes (%.1f%%) saved\n", stats.Size, stats.StoredSize, saved, ratio)
+	return nil
+}
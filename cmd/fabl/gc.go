@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"api.fabl.app/internal/sql"
+	"github.com/urfave/cli/v2"
+)
+
+var gcCommand = &cli.Command{
+	Name:   "gc",
+	Usage:  "Removes item data no longer referenced by any item",
+	Action: gcAction,
+
+	Flags: []cli.Flag{
+		dbDSNFlag,
+		blobStoreFlag,
+		&cli.BoolFlag{
+			Name:    "dry-run",
+			Aliases: []string{"n"},
+			Usage:   "only report what would be removed",
+		},
+	},
+}
+
+func gcAction(c *cli.Context) error {
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	blobs, err := openBlobStore(c)
+	if err != nil {
+		return err
+	}
+
+	repo := sql.NewRepository(db, blobs)
+	n, size, err := repo.Item.CollectGarbage(c.Context, c.Bool("dry-run"))
+	if err != nil {
+		return err
+	}
+	if c.Bool("dry-run") {
+		fmt.Printf("%d unreferenced blobs, %d bytes reclaimable\n", n, size)
+	} else {
+		fmt.Printf("removed %d unreferenced blobs, %d bytes reclaimed\n", n, size)
+	}
+	return nil
+}
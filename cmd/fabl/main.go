@@ -1,12 +1,49 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
+	"api.fabl.app/internal/blob"
+	"api.fabl.app/internal/sql"
+	"github.com/jmoiron/sqlx"
 	"github.com/urfave/cli/v2"
 )
 
+var dbDSNFlag = &cli.StringFlag{
+	Name:    "db-dsn",
+	Usage:   "PostgreSQL connection string, or sqlite:<path> for SQLite",
+	EnvVars: []string{"DB_DSN"},
+}
+
+var blobStoreFlag = &cli.StringFlag{
+	Name:    "blob-store",
+	Usage:   "keep new item data in file:///path/to/dir or s3://bucket/prefix instead of the database",
+	EnvVars: []string{"BLOB_STORE"},
+}
+
+// openBlobStore opens the store of the blob-store flag, nil if unset.
+func openBlobStore(c *cli.Context) (blob.Store, error) {
+	if c.String("blob-store") == "" {
+		return nil, nil
+	}
+	blobs, err := blob.Open(c.String("blob-store"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob store: %w", err)
+	}
+	return blobs, nil
+}
+
+// connect connects to the database of the db-dsn flag.
+func connect(c *cli.Context) (*sqlx.DB, error) {
+	db, err := sql.Connect(c.String("db-dsn"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to db: %w", err)
+	}
+	return db, nil
+}
+
 func main() {
 	app := &cli.App{
 		Name:                   "factorio-blueprints",
@@ -16,6 +53,10 @@ func main() {
 
 		Commands: []*cli.Command{
 			serverCommand,
+			blobsCommand,
+			gcCommand,
+			migrateCommand,
+			reindexCommand,
 		},
 	}
 
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+
+	"api.fabl.app/internal/sql"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateCommand = &cli.Command{
+	Name:  "migrate",
+	Usage: "Applies or reverts the migrations of the database schema",
+
+	Subcommands: []*cli.Command{
+		{
+			Name:   "up",
+			Usage:  "Applies the pending migrations",
+			Action: migrateUpAction,
+			Flags: []cli.Flag{
+				dbDSNFlag,
+				&cli.IntFlag{
+					Name:  "to",
+					Usage: "only apply migrations up to this version",
+				},
+			},
+		},
+		{
+			Name:   "down",
+			Usage:  "Reverts the latest applied migrations",
+			Action: migrateDownAction,
+			Flags: []cli.Flag{
+				dbDSNFlag,
+				&cli.IntFlag{
+					Name:  "steps",
+					Usage: "number of migrations to revert",
+					Value: 1,
+				},
+			},
+		},
+		{
+			Name:   "status",
+			Usage:  "Lists the migrations and when they were applied",
+			Action: migrateStatusAction,
+			Flags: []cli.Flag{
+				dbDSNFlag,
+			},
+		},
+	},
+}
+
+func migrateUpAction(c *cli.Context) error {
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	applied, err := sql.MigrateUp(c.Context, db, c.Int("to"))
+	for _, m := range applied {
+		fmt.Printf("applied %d_%s\n", m.Version, m.Name)
+	}
+	return err
+}
+
+func migrateDownAction(c *cli.Context) error {
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	reverted, err := sql.MigrateDown(c.Context, db, c.Int("steps"))
+	for _, m := range reverted {
+		fmt.Printf("reverted %d_%s\n", m.Version, m.Name)
+	}
+	return err
+}
+
+func migrateStatusAction(c *cli.Context) error {
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	status, err := sql.Status(c.Context, db)
+	if err != nil {
+		return err
+	}
+	for _, m := range status {
+		applied := "pending"
+		if m.AppliedAt != nil {
+			applied = "applied " + m.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+		fmt.Printf("%04d_%-20s %s\n", m.Version, m.Name, applied)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"api.fabl.app/internal/service"
+	"api.fabl.app/internal/sql"
+	"github.com/urfave/cli/v2"
+)
+
+var reindexCommand = &cli.Command{
+	Name:   "reindex",
+	Usage:  "Indexes the item data stored before search, so it is found by searches and filters",
+	Action: reindexAction,
+
+	Flags: []cli.Flag{
+		dbDSNFlag,
+		blobStoreFlag,
+		&cli.IntFlag{
+			Name:  "batch-size",
+			Usage: "number of blobs indexed per transaction",
+			Value: 100,
+		},
+	},
+}
+
+func reindexAction(c *cli.Context) error {
+	blobs, err := openBlobStore(c)
+	if err != nil {
+		return err
+	}
+	db, err := connect(c)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	n, err := sql.IndexData(c.Context, db, blobs, c.Int("batch-size"), service.SearchIndex)
+	fmt.Printf("indexed %d blobs\n", n)
+	return err
+}
@@ -3,21 +3,27 @@ package main
 import (
 	"context"
 	"fmt"
+	"log"
 	"net"
 	"net/http"
+	"time"
 
+	"api.fabl.app/internal/blueprint"
 	"api.fabl.app/internal/embed"
+	"api.fabl.app/internal/memory"
+	"api.fabl.app/internal/preview"
+	"api.fabl.app/internal/repository"
 	"api.fabl.app/internal/service"
 	"api.fabl.app/internal/session"
 	"api.fabl.app/internal/sql"
 	pb "api.fabl.app/pb/fabl/v1"
+	"github.com/google/uuid"
 	"github.com/gorilla/securecookie"
 	"github.com/gorilla/sessions"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/jmoiron/sqlx"
-	_ "github.com/lib/pq"
 	"github.com/rs/cors"
 	"github.com/urfave/cli/v2"
+	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -53,9 +59,16 @@ var serverCommand = &cli.Command{
 			Value:   8081,
 			EnvVars: []string{"GRPC_PORT"},
 		},
-		&cli.StringFlag{
-			Name:    "db-dsn",
-			EnvVars: []string{"DB_DSN"},
+		dbDSNFlag,
+		blobStoreFlag,
+		&cli.BoolFlag{
+			Name:  "demo",
+			Usage: "keep everything in memory instead of a database, with a demo account",
+		},
+		&cli.BoolFlag{
+			Name:    "auto-migrate",
+			Usage:   "apply pending migrations of the database schema on start",
+			EnvVars: []string{"AUTO_MIGRATE"},
 		},
 		&cli.IntFlag{
 			Name:    "session-cookie-max-age",
@@ -79,6 +92,71 @@ var serverCommand = &cli.Command{
 		// 	Name:    "session-cookie-block-key",
 		// 	EnvVars: []string{"SESSION_COOKIE_BLOCK_KEY"},
 		// },
+		&cli.IntFlag{
+			Name:    "bcrypt-cost",
+			Value:   bcrypt.DefaultCost,
+			EnvVars: []string{"BCRYPT_COST"},
+		},
+		&cli.IntFlag{
+			Name:    "min-password-length",
+			Value:   8,
+			EnvVars: []string{"MIN_PASSWORD_LENGTH"},
+		},
+		&cli.BoolFlag{
+			Name:    "closed-registration",
+			Usage:   "reject the registration of new accounts",
+			EnvVars: []string{"CLOSED_REGISTRATION"},
+		},
+		&cli.StringSliceFlag{
+			Name:    "invite-code",
+			Usage:   "require one of these codes to register",
+			EnvVars: []string{"INVITE_CODES"},
+		},
+		&cli.IntFlag{
+			Name:    "max-import-size",
+			Value:   4 << 20,
+			EnvVars: []string{"MAX_IMPORT_SIZE"},
+		},
+		&cli.Int64Flag{
+			Name:    "max-data-size",
+			Value:   32 << 20,
+			EnvVars: []string{"MAX_DATA_SIZE"},
+		},
+		&cli.StringFlag{
+			Name:    "max-game-version",
+			Usage:   "newest supported game version, e.g. 1.1",
+			EnvVars: []string{"MAX_GAME_VERSION"},
+		},
+		&cli.BoolFlag{
+			Name:    "reject-newer-game-versions",
+			EnvVars: []string{"REJECT_NEWER_GAME_VERSIONS"},
+		},
+		&cli.BoolFlag{
+			Name:    "keep-original-imports",
+			EnvVars: []string{"KEEP_ORIGINAL_IMPORTS"},
+		},
+		&cli.DurationFlag{
+			Name:    "trash-retention",
+			Usage:   "how long deleted items are kept, 0 keeps them until purged",
+			Value:   30 * 24 * time.Hour,
+			EnvVars: []string{"TRASH_RETENTION"},
+		},
+		&cli.DurationFlag{
+			Name:    "trash-purge-interval",
+			Value:   time.Hour,
+			EnvVars: []string{"TRASH_PURGE_INTERVAL"},
+		},
+		&cli.DurationFlag{
+			Name:    "gc-interval",
+			Usage:   "how often unreferenced item data is removed, 0 disables it",
+			EnvVars: []string{"GC_INTERVAL"},
+		},
+		&cli.Int64Flag{
+			Name:    "preview-cache-bytes",
+			Usage:   "memory used to cache rendered previews",
+			Value:   64 << 20,
+			EnvVars: []string{"PREVIEW_CACHE_BYTES"},
+		},
 		&cli.StringSliceFlag{
 			Name: "cors-allowed-origins",
 			Value: cli.NewStringSlice(
@@ -93,20 +171,126 @@ func serverAction(c *cli.Context) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	db, err := sqlx.Connect("postgres", c.String("db-dsn"))
+	var maxGameVersion blueprint.Version
+	if c.IsSet("max-game-version") {
+		var err error
+		_, maxGameVersion, err = blueprint.ParseVersion(c.String("max-game-version"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if cost := c.Int("bcrypt-cost"); cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return fmt.Errorf("bcrypt cost %d out of range %d to %d", cost, bcrypt.MinCost, bcrypt.MaxCost)
+	}
+
+	var (
+		accounts repository.AccountRepository
+		items    repository.ItemRepository
+	)
+	if c.Bool("demo") {
+		repo, err := demoRepository()
+		if err != nil {
+			return err
+		}
+		accounts, items = repo.Account, repo.Item
+	} else {
+		db, err := connect(c)
+		if err != nil {
+			return err
+		}
+		if c.Bool("auto-migrate") {
+			applied, err := sql.MigrateUp(ctx, db, 0)
+			if err != nil {
+				return fmt.Errorf("failed to migrate db: %w", err)
+			}
+			for _, m := range applied {
+				log.Printf("applied migration %d_%s", m.Version, m.Name)
+			}
+		}
+		blobs, err := openBlobStore(c)
+		if err != nil {
+			return err
+		}
+		repo := sql.NewRepository(db, blobs)
+		accounts, items = repo.Account, repo.Item
+	}
+
+	accountSrv, err := service.NewAccountServiceServer(accounts, service.AccountServiceConfig{
+		BcryptCost:         c.Int("bcrypt-cost"),
+		MinPasswordLength:  c.Int("min-password-length"),
+		ClosedRegistration: c.Bool("closed-registration"),
+		InviteCodes:        c.StringSlice("invite-code"),
+	})
 	if err != nil {
-		return fmt.Errorf("failed to connect to db: %w", err)
+		return err
 	}
 
 	var (
-		repo = sql.NewRepository(db)
+		itemSrv = service.NewItemServiceServer(items, service.ItemServiceConfig{
+			MaxImportSize: c.Int("max-import-size"),
+			MaxDataSize:   c.Int64("max-data-size"),
+			KeepOriginal:  c.Bool("keep-original-imports"),
 
-		itemSrv    = service.NewItemServiceServer(repo.Item)
-		accountSrv = service.NewAccountServiceServer(repo.Account)
+			TrashRetention: c.Duration("trash-retention"),
 
-		g errgroup.Group
+			MaxGameVersion:          maxGameVersion,
+			RejectNewerGameVersions: c.Bool("reject-newer-game-versions"),
+		})
 	)
 
+	// The first error cancels ctx, which stops the servers and the
+	// background loops.
+	g, ctx := errgroup.WithContext(ctx)
+
+	g.Go(func() error {
+		retention := c.Duration("trash-retention")
+		if retention <= 0 {
+			return nil
+		}
+		t := time.NewTicker(c.Duration("trash-purge-interval"))
+		defer t.Stop()
+		for {
+			n, err := items.PurgeExpiredTrash(ctx, time.Now().Add(-retention))
+			if err != nil {
+				log.Printf("failed to purge trash: %v", err)
+			} else if n > 0 {
+				log.Printf("purged %d items from the trash", n)
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+		}
+	})
+
+	g.Go(func() error {
+		interval := c.Duration("gc-interval")
+		if interval <= 0 {
+			return nil
+		}
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-t.C:
+			}
+			n, size, err := items.CollectGarbage(ctx, false)
+			if ctx.Err() != nil {
+				// Interrupted by another goroutine of g failing.
+				return nil
+			}
+			if err != nil {
+				log.Printf("failed to collect garbage: %v", err)
+			} else if n > 0 {
+				log.Printf("removed %d unreferenced blobs, %d bytes", n, size)
+			}
+		}
+	})
+
 	g.Go(func() error {
 		if !c.Bool("grpc") {
 			return nil
@@ -121,6 +305,10 @@ func serverAction(c *cli.Context) error {
 		if err != nil {
 			return err
 		}
+		go func() {
+			<-ctx.Done()
+			s.Stop()
+		}()
 		return s.Serve(l)
 	})
 
@@ -155,6 +343,10 @@ func serverAction(c *cli.Context) error {
 			embed.Handler.ServeHTTP(w, r)
 		})
 
+		previews := preview.NewHandler(items, c.Int64("preview-cache-bytes"))
+		mux.HandlePath(http.MethodGet, "/v1/items/{id}/preview.svg", previews.SVG)
+		mux.HandlePath(http.MethodGet, "/v1/items/{id}/preview.png", previews.PNG)
+
 		var codecs []securecookie.Codec
 		if c.IsSet("session-cookie-key") {
 			for _, hashKey := range c.StringSlice("session-cookie-key") {
@@ -176,14 +368,45 @@ func serverAction(c *cli.Context) error {
 
 		cors := cors.New(cors.Options{
 			AllowedOrigins:   c.StringSlice("cors-allowed-origins"),
-			AllowedMethods:   []string{"GET", "POST"},
+			AllowedMethods:   []string{"GET", "POST", "PATCH", "DELETE"},
 			AllowCredentials: true,
 		})
-		return http.ListenAndServe(
-			fmt.Sprintf(":%d", c.Int("port")),
-			cors.Handler(session.Wrap(mux, c.String("session-cookie-name"), cs)),
-		)
+		srv := &http.Server{
+			Addr:    fmt.Sprintf(":%d", c.Int("port")),
+			Handler: cors.Handler(session.Wrap(mux, c.String("session-cookie-name"), cs)),
+		}
+		go func() {
+			<-ctx.Done()
+			srv.Close()
+		}()
+		err := srv.ListenAndServe()
+		if err == http.ErrServerClosed && ctx.Err() != nil {
+			return nil
+		}
+		return err
 	})
 
 	return g.Wait()
 }
+
+// demoRepository returns an in-memory repository with an account to log in
+// with, whose credentials are logged.
+func demoRepository() (*memory.Repository, error) {
+	const password = "demo"
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+	acc := &repository.Account{
+		ID:             uuid.New(),
+		HashedPassword: hash,
+		Nickname:       "demo",
+	}
+	repo := memory.NewRepository()
+	err = repo.Account.Create(context.Background(), acc)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("demo mode, nothing is stored: log in with nickname %q and password %q", acc.Nickname, password)
+	return repo, nil
+}
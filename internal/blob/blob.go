@@ -0,0 +1,78 @@
+// Package blob stores item data outside of the database, keyed by its
+// SHA-256.
+package blob
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+)
+
+// ErrNotFound is returned by Store.Get when no blob has the given key.
+var ErrNotFound = errors.New("blob not found")
+
+// Key identifies a blob by the SHA-256 of its decoded content, and the codec
+// it is encoded with, empty if not encoded.
+type Key struct {
+	Sum256 [32]byte
+	Codec  string
+}
+
+// String returns the name of a blob, spread over directories by the first
+// byte of its sum, with the codec as extension.
+func (k Key) String() string {
+	s := hex.EncodeToString(k.Sum256[:])
+	s = s[:2] + "/" + s
+	if k.Codec != "" {
+		s += "." + k.Codec
+	}
+	return s
+}
+
+// Store keeps blobs by Key. Storing a blob again has no effect.
+type Store interface {
+	Get(ctx context.Context, key Key) ([]byte, error)
+	Put(ctx context.Context, key Key, data []byte) error
+	// Delete removes a blob, if present.
+	Delete(ctx context.Context, key Key) error
+}
+
+// Open opens the store of a URL, either file:///path/to/dir for a directory
+// or s3://bucket/prefix for a bucket of S3, or any compatible service given
+// by an endpoint query parameter, e.g.
+// s3://fabl/blobs?endpoint=http://localhost:9000&region=us-east-1.
+//
+// The credentials of S3 are read from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+func Open(rawurl string) (Store, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "file":
+		if u.Path == "" {
+			return nil, fmt.Errorf("blob store %q lacks a path", rawurl)
+		}
+		return NewFileStore(u.Path)
+	case "s3":
+		if u.Host == "" {
+			return nil, fmt.Errorf("blob store %q lacks a bucket", rawurl)
+		}
+		q := u.Query()
+		return NewS3Store(S3Config{
+			Endpoint:        q.Get("endpoint"),
+			Region:          q.Get("region"),
+			Bucket:          u.Host,
+			Prefix:          u.Path,
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported blob store %q", rawurl)
+	}
+}
@@ -0,0 +1,142 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testStore(t *testing.T, s Store) {
+	ctx := context.Background()
+	data := []byte(`{"blueprint":{}}`)
+	key := Key{Sum256: sha256.Sum256(data)}
+
+	_, err := s.Get(ctx, key)
+	if err != ErrNotFound {
+		t.Fatalf("Get of a missing blob: got error %v, want ErrNotFound", err)
+	}
+	for i := 0; i < 2; i++ {
+		err = s.Put(ctx, key, data)
+		if err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+	}
+	got, err := s.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("Get = %q, want %q", got, data)
+	}
+	for i := 0; i < 2; i++ {
+		err = s.Delete(ctx, key)
+		if err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+	}
+	_, err = s.Get(ctx, key)
+	if err != ErrNotFound {
+		t.Errorf("Get of a deleted blob: got error %v, want ErrNotFound", err)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	s, err := Open("file://" + t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, s)
+}
+
+// fakeS3 stands in for an S3 bucket, keeping objects by path.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") {
+		http.Error(w, "AccessDenied", http.StatusForbidden)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := f.objects[r.URL.Path]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		w.Write(data)
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.objects[r.URL.Path] = data
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	fake := &fakeS3{objects: make(map[string][]byte)}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	os.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	defer os.Unsetenv("AWS_ACCESS_KEY_ID")
+	os.Setenv("AWS_SECRET_ACCESS_KEY", "SECRET")
+	defer os.Unsetenv("AWS_SECRET_ACCESS_KEY")
+
+	s, err := Open("s3://fabl/blobs/?endpoint=" + server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, s)
+
+	err = s.Put(context.Background(), Key{Sum256: sha256.Sum256(nil), Codec: "zlib"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "/fabl/blobs/e3/e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855.zlib"
+	if _, ok := fake.objects[want]; !ok {
+		t.Errorf("Put stored %v, want %s", fake.objects, want)
+	}
+}
+
+func TestS3Sign(t *testing.T) {
+	s, err := NewS3Store(S3Config{
+		Endpoint:        "http://localhost:9000",
+		Bucket:          "my-bucket",
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := []byte("hello")
+	req, err := http.NewRequest(http.MethodPut, "http://localhost:9000/my-bucket/pre%20fix/ab/abcd", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.sign(req, body, time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC))
+	// As signed by the AWS SDK for Go.
+	want := "AWS4-HMAC-SHA256 Credential=AKID/20210203/us-east-1/s3/aws4_request, " +
+		"SignedHeaders=host;x-amz-content-sha256;x-amz-date;x-amz-security-token, " +
+		"Signature=ad714df89d37045059925b6e552a2bea03413dfe9094b9993354c583a3ab5169"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Errorf("Authorization = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,75 @@
+package blob
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+)
+
+// FileStore keeps blobs as files in a directory.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a store keeping blobs in dir, which is created if
+// missing.
+func NewFileStore(dir string) (*FileStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) path(key Key) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key.String()))
+}
+
+func (s *FileStore) Get(ctx context.Context, key Key) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return data, err
+}
+
+// Put writes the blob to a temporary file renamed into place, so a blob is
+// never seen partially written.
+func (s *FileStore) Put(ctx context.Context, key Key, data []byte) error {
+	path := s.path(key)
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Sync()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	err = f.Close()
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *FileStore) Delete(ctx context.Context, key Key) error {
+	err := os.Remove(s.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,199 @@
+package blob
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// S3Config configures an S3Store.
+type S3Config struct {
+	// Endpoint is the base URL of the service, by default that of AWS in
+	// Region. Buckets are addressed by path, e.g. https://host/bucket/key.
+	Endpoint string
+	// Region defaults to us-east-1.
+	Region string
+	Bucket string
+	// Prefix is prepended to the names of blobs.
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// S3Store keeps blobs in a bucket of S3, or a compatible service.
+type S3Store struct {
+	config S3Config
+	base   *url.URL
+}
+
+func NewS3Store(config S3Config) (*S3Store, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("missing bucket")
+	}
+	if config.Region == "" {
+		config.Region = "us-east-1"
+	}
+	if config.Endpoint == "" {
+		config.Endpoint = "https://s3." + config.Region + ".amazonaws.com"
+	}
+	if config.Client == nil {
+		config.Client = http.DefaultClient
+	}
+	base, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if base.Scheme != "http" && base.Scheme != "https" || base.Host == "" {
+		return nil, fmt.Errorf("malformed endpoint %q", config.Endpoint)
+	}
+	config.Prefix = strings.Trim(config.Prefix, "/")
+	return &S3Store{config: config, base: base}, nil
+}
+
+func (s *S3Store) Get(ctx context.Context, key Key) ([]byte, error) {
+	res, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, responseError(res)
+	}
+	return io.ReadAll(res.Body)
+}
+
+func (s *S3Store) Put(ctx context.Context, key Key, data []byte) error {
+	res, err := s.do(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return responseError(res)
+	}
+	return nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key Key) error {
+	res, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	// Deleting a missing object succeeds with 204, some services answer 404.
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK &&
+		res.StatusCode != http.StatusNotFound {
+		return responseError(res)
+	}
+	return nil
+}
+
+// do sends a signed request for the object of a blob.
+func (s *S3Store) do(ctx context.Context, method string, key Key, body []byte) (*http.Response, error) {
+	segments := []string{s.config.Bucket}
+	if s.config.Prefix != "" {
+		segments = append(segments, strings.Split(s.config.Prefix, "/")...)
+	}
+	segments = append(segments, strings.Split(key.String(), "/")...)
+	for i := range segments {
+		segments[i] = uriEncode(segments[i])
+	}
+	u := *s.base
+	u.RawPath = strings.TrimSuffix(u.EscapedPath(), "/") + "/" + strings.Join(segments, "/")
+	u.Path, _ = url.PathUnescape(u.RawPath)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, body, time.Now().UTC())
+	return s.config.Client.Do(req)
+}
+
+// sign adds the AWS Signature Version 4 of req to its headers.
+func (s *S3Store) sign(req *http.Request, body []byte, now time.Time) {
+	var (
+		amzDate     = now.Format("20060102T150405Z")
+		date        = now.Format("20060102")
+		payloadHash = sha256.Sum256(body)
+		scope       = date + "/" + s.config.Region + "/s3/aws4_request"
+	)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(payloadHash[:]))
+	signed := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if s.config.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.config.SessionToken)
+		signed = append(signed, "x-amz-security-token")
+	}
+	var headers strings.Builder
+	for _, name := range signed {
+		value := req.Header.Get(name)
+		if name == "host" {
+			value = req.URL.Host
+		}
+		headers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		headers.String(),
+		strings.Join(signed, ";"),
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonical))
+	toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(canonicalHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	for _, part := range []string{s.config.Region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, strings.Join(signed, ";"),
+		hex.EncodeToString(hmacSHA256(signingKey, toSign)),
+	))
+}
+
+func hmacSHA256(key []byte, s string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(s))
+	return h.Sum(nil)
+}
+
+// uriEncode escapes a path segment as AWS signatures expect, everything but
+// unreserved characters.
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func responseError(res *http.Response) error {
+	b, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+	return fmt.Errorf("blob store: %s %s: %s: %s", res.Request.Method, res.Request.URL.Path, res.Status, bytes.TrimSpace(b))
+}
@@ -0,0 +1,164 @@
+// Package blueprint decodes the JSON found in Factorio import strings into
+// the fabl.v1 messages, and encodes them back.
+package blueprint
+
+import (
+	"encoding/json"
+	"errors"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+// ErrUnrecognized is returned when the JSON object does not contain any known
+// Factorio object.
+var ErrUnrecognized = errors.New("blueprint: unrecognized object")
+
+// Kinds of Factorio objects, as used for the top level key of their JSON.
+const (
+	KindBlueprint             = "blueprint"
+	KindBlueprintBook         = "blueprint_book"
+	KindDeconstructionPlanner = "deconstruction_planner"
+	KindUpgradePlanner        = "upgrade_planner"
+)
+
+type object struct {
+	Blueprint             *blueprint             `json:"blueprint,omitempty"`
+	BlueprintBook         *blueprintBook         `json:"blueprint_book,omitempty"`
+	DeconstructionPlanner *deconstructionPlanner `json:"deconstruction_planner,omitempty"`
+	UpgradePlanner        *upgradePlanner        `json:"upgrade_planner,omitempty"`
+}
+
+// Kind returns the kind of the Factorio object in data.
+func Kind(data []byte) (string, error) {
+	var v map[string]json.RawMessage
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return "", err
+	}
+	if len(v) == 1 {
+		for k := range v {
+			switch k {
+			case KindBlueprint, KindBlueprintBook, KindDeconstructionPlanner, KindUpgradePlanner:
+				return k, nil
+			}
+		}
+	}
+	return "", ErrUnrecognized
+}
+
+// Decode parses inflated import string data into an Item. The ImportString of
+// the returned Item is left empty.
+func Decode(data []byte) (*pb.Item, error) {
+	var v object
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return nil, err
+	}
+	return v.proto()
+}
+
+// Encode returns the JSON representation of an Item, as it would be found in
+// an import string. Decoding the result yields an Item equal to item.
+func Encode(item *pb.Item) ([]byte, error) {
+	v, err := fromItem(item)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+func (o *object) proto() (*pb.Item, error) {
+	switch {
+	case o.Blueprint != nil:
+		b, err := o.Blueprint.proto()
+		if err != nil {
+			return nil, err
+		}
+		return &pb.Item{Item: &pb.Item_Blueprint{Blueprint: b}}, nil
+	case o.BlueprintBook != nil:
+		b, err := o.BlueprintBook.proto()
+		if err != nil {
+			return nil, err
+		}
+		return &pb.Item{Item: &pb.Item_BlueprintBook{BlueprintBook: b}}, nil
+	case o.DeconstructionPlanner != nil:
+		return &pb.Item{Item: &pb.Item_DeconstructionPlanner{
+			DeconstructionPlanner: o.DeconstructionPlanner.proto(),
+		}}, nil
+	case o.UpgradePlanner != nil:
+		return &pb.Item{Item: &pb.Item_UpgradePlanner{
+			UpgradePlanner: o.UpgradePlanner.proto(),
+		}}, nil
+	}
+	return nil, ErrUnrecognized
+}
+
+func fromItem(item *pb.Item) (*object, error) {
+	var (
+		v   object
+		err error
+	)
+	switch x := item.Item.(type) {
+	case *pb.Item_Blueprint:
+		v.Blueprint, err = fromBlueprint(x.Blueprint)
+	case *pb.Item_BlueprintBook:
+		v.BlueprintBook, err = fromBlueprintBook(x.BlueprintBook)
+	case *pb.Item_DeconstructionPlanner:
+		v.DeconstructionPlanner = fromDeconstructionPlanner(x.DeconstructionPlanner)
+	case *pb.Item_UpgradePlanner:
+		v.UpgradePlanner = fromUpgradePlanner(x.UpgradePlanner)
+	default:
+		return nil, ErrUnrecognized
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Summary returns the kind, label and icons of item.
+func Summary(item *pb.Item) (kind, label string, icons []*pb.Icon) {
+	switch x := item.Item.(type) {
+	case *pb.Item_Blueprint:
+		return KindBlueprint, x.Blueprint.Label, x.Blueprint.Icons
+	case *pb.Item_BlueprintBook:
+		return KindBlueprintBook, x.BlueprintBook.Label, x.BlueprintBook.Icons
+	case *pb.Item_DeconstructionPlanner:
+		return KindDeconstructionPlanner, x.DeconstructionPlanner.Label, x.DeconstructionPlanner.Icons
+	case *pb.Item_UpgradePlanner:
+		return KindUpgradePlanner, x.UpgradePlanner.Label, x.UpgradePlanner.Icons
+	}
+	return "", "", nil
+}
+
+// Entry wraps item as an entry of a blueprint book.
+func Entry(index uint64, item *pb.Item) *pb.BlueprintBookEntry {
+	e := &pb.BlueprintBookEntry{Index: index}
+	switch x := item.Item.(type) {
+	case *pb.Item_Blueprint:
+		e.Entry = &pb.BlueprintBookEntry_Blueprint{Blueprint: x.Blueprint}
+	case *pb.Item_BlueprintBook:
+		e.Entry = &pb.BlueprintBookEntry_BlueprintBook{BlueprintBook: x.BlueprintBook}
+	case *pb.Item_DeconstructionPlanner:
+		e.Entry = &pb.BlueprintBookEntry_DeconstructionPlanner{DeconstructionPlanner: x.DeconstructionPlanner}
+	case *pb.Item_UpgradePlanner:
+		e.Entry = &pb.BlueprintBookEntry_UpgradePlanner{UpgradePlanner: x.UpgradePlanner}
+	}
+	return e
+}
+
+// EntryItem returns the content of a blueprint book entry as an Item.
+func EntryItem(e *pb.BlueprintBookEntry) *pb.Item {
+	item := &pb.Item{}
+	switch x := e.Entry.(type) {
+	case *pb.BlueprintBookEntry_Blueprint:
+		item.Item = &pb.Item_Blueprint{Blueprint: x.Blueprint}
+	case *pb.BlueprintBookEntry_BlueprintBook:
+		item.Item = &pb.Item_BlueprintBook{BlueprintBook: x.BlueprintBook}
+	case *pb.BlueprintBookEntry_DeconstructionPlanner:
+		item.Item = &pb.Item_DeconstructionPlanner{DeconstructionPlanner: x.DeconstructionPlanner}
+	case *pb.BlueprintBookEntry_UpgradePlanner:
+		item.Item = &pb.Item_UpgradePlanner{UpgradePlanner: x.UpgradePlanner}
+	}
+	return item
+}
@@ -0,0 +1,74 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDecodeEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"blueprint", "testdata/blueprint.json"},
+		{"blueprint book", "testdata/blueprint_book.json"},
+		{"deconstruction planner", "testdata/deconstruction_planner.json"},
+		{"upgrade planner", "testdata/upgrade_planner.json"},
+		{"opaque label color", `{"blueprint":{"item":"blueprint","label_color":{"r":1,"g":1,"b":1,"a":1},"version":1}}`},
+		{"transparent label color", `{"blueprint":{"item":"blueprint","label_color":{"r":1,"g":1,"b":1,"a":0},"version":1}}`},
+		{"unrecognized book entry", `{"blueprint_book":{"item":"blueprint-book","active_index":0,"version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","version":1}},
+			{"index":1,"future_planner":{"item":"future-planner","version":1}}]}}`},
+		{"unknown entry property", `{"blueprint_book":{"item":"blueprint-book","active_index":0,"version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","version":1},"pinned":true}]}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(tt.data)
+			if filepath.Ext(tt.data) == ".json" {
+				data = readFile(t, tt.data)
+			}
+			item, err := Decode(data)
+			if err != nil {
+				t.Fatalf("Decode: %v", err)
+			}
+			encoded, err := Encode(item)
+			if err != nil {
+				t.Fatalf("Encode: %v", err)
+			}
+			var want, got interface{}
+			err = json.Unmarshal(data, &want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = json.Unmarshal(encoded, &got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Encode(Decode(data)) = %s\nwant %s", encoded, data)
+			}
+			again, err := Decode(encoded)
+			if err != nil {
+				t.Fatalf("Decode of the encoded item: %v", err)
+			}
+			if !proto.Equal(again, item) {
+				t.Errorf("Decode(Encode(item)) = %v, want %v", again, item)
+			}
+		})
+	}
+}
+
+func TestColorAlpha(t *testing.T) {
+	item, err := Decode([]byte(`{"blueprint":{"item":"blueprint","label_color":{"r":1,"g":0,"b":0},"version":1}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a := item.GetBlueprint().LabelColor.A; a != nil {
+		t.Errorf("A = %v, want nil for a missing alpha", *a)
+	}
+}
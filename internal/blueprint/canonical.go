@@ -0,0 +1,85 @@
+package blueprint
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Canonicalize returns a canonical form of the JSON in data: object keys are
+// sorted, numbers are written in their shortest form and object members that
+// are null, empty arrays or empty objects are dropped. Equivalent objects
+// exported by the game, e.g. with different key order or whitespace,
+// canonicalize to the same bytes.
+func Canonicalize(data []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	v, _ = canonical(v)
+	if v == nil {
+		v = map[string]interface{}{}
+	}
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	err = enc.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// canonical returns the canonical form of v, and false if v is insignificant
+// and should be dropped.
+func canonical(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case map[string]interface{}:
+		for k, e := range v {
+			e, ok := canonical(e)
+			if !ok {
+				delete(v, k)
+				continue
+			}
+			v[k] = e
+		}
+		return v, len(v) > 0
+	case []interface{}:
+		// Elements are never dropped, their position may be significant.
+		for i, e := range v {
+			v[i], _ = canonical(e)
+		}
+		return v, len(v) > 0
+	case json.Number:
+		return canonicalNumber(v), true
+	}
+	return v, true
+}
+
+func canonicalNumber(n json.Number) json.Number {
+	s := n.String()
+	if !strings.ContainsAny(s, ".eE") {
+		// Integers are kept exact, as version numbers don't fit in a float64.
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return json.Number(strconv.FormatInt(i, 10))
+		}
+		if u, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return json.Number(strconv.FormatUint(u, 10))
+		}
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return n
+	}
+	if f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		return json.Number(strconv.FormatInt(int64(f), 10))
+	}
+	return json.Number(strconv.FormatFloat(f, 'g', -1, 64))
+}
@@ -0,0 +1,125 @@
+package blueprint
+
+import "testing"
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []string
+		want string
+	}{
+		{
+			"key order",
+			[]string{`{"b":1,"a":{"d":2,"c":3}}`, `{"a":{"c":3,"d":2},"b":1}`},
+			`{"a":{"c":3,"d":2},"b":1}`,
+		},
+		{
+			"whitespace",
+			[]string{"{ \"a\" : [ 1 , 2 ] ,\n\t\"b\" : \"x y\" }\n", `{"a":[1,2],"b":"x y"}`},
+			`{"a":[1,2],"b":"x y"}`,
+		},
+		{
+			"integer forms",
+			[]string{`{"x":1}`, `{"x":1.0}`, `{"x":1e0}`, `{"x":10E-1}`, `{"x":0.1e1}`},
+			`{"x":1}`,
+		},
+		{
+			"fraction forms",
+			[]string{`{"x":0.5}`, `{"x":5e-1}`, `{"x":0.50}`, `{"x":50E-2}`},
+			`{"x":0.5}`,
+		},
+		{
+			"negative zero",
+			[]string{`{"x":-0}`, `{"x":0.0}`, `{"x":-0.0}`},
+			`{"x":0}`,
+		},
+		{
+			"large integers kept exact",
+			[]string{`{"version":281479274823681}`, `{"version":281479274823681.0}`},
+			`{"version":281479274823681}`,
+		},
+		{
+			"uint64 versions",
+			[]string{`{"version":18446744073709551615}`},
+			`{"version":18446744073709551615}`,
+		},
+		{
+			"null and empty members dropped",
+			[]string{`{"a":1,"b":null,"c":[],"d":{},"e":{"f":null,"g":[]}}`, `{"a":1}`},
+			`{"a":1}`,
+		},
+		{
+			"array elements kept",
+			[]string{`{"a":[null,{},[],1]}`},
+			`{"a":[null,{},[],1]}`,
+		},
+		{
+			"empty root",
+			[]string{`{"a":null}`, `{}`},
+			`{}`,
+		},
+		{
+			"strings not escaped for HTML",
+			[]string{`{"s":"a<b>&c"}`, `{"s":"a<b>&c"}`},
+			`{"s":"a<b>&c"}`,
+		},
+		{
+			"false and zero kept",
+			[]string{`{"a":false,"b":0,"c":""}`},
+			`{"a":false,"b":0,"c":""}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, in := range tt.in {
+				got, err := Canonicalize([]byte(in))
+				if err != nil {
+					t.Fatalf("Canonicalize(%s): %v", in, err)
+				}
+				if string(got) != tt.want {
+					t.Errorf("Canonicalize(%s) = %s, want %s", in, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCanonicalizeExport(t *testing.T) {
+	data := readFile(t, "testdata/blueprint.json")
+	want, err := Canonicalize(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The same blueprint, as encoded again.
+	item, err := Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := Encode(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Canonicalize(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Canonicalize of the encoded blueprint = %s\nwant %s", got, want)
+	}
+	again, err := Canonicalize(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(again) != string(got) {
+		t.Errorf("Canonicalize is not idempotent: %s\nthen %s", got, again)
+	}
+}
+
+func TestCanonicalizeInvalid(t *testing.T) {
+	for _, in := range []string{``, `{`, `{"a":}`} {
+		_, err := Canonicalize([]byte(in))
+		if err == nil {
+			t.Errorf("Canonicalize(%q): got no error", in)
+		}
+	}
+}
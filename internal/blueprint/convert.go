@@ -0,0 +1,357 @@
+package blueprint
+
+import (
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+func (p *position) proto() *pb.Position {
+	if p == nil {
+		return nil
+	}
+	return &pb.Position{X: p.X, Y: p.Y}
+}
+
+func fromPosition(p *pb.Position) *position {
+	if p == nil {
+		return nil
+	}
+	return &position{X: p.X, Y: p.Y}
+}
+
+func (c *color) proto() *pb.Color {
+	if c == nil {
+		return nil
+	}
+	return &pb.Color{R: c.R, G: c.G, B: c.B, A: c.A}
+}
+
+func fromColor(c *pb.Color) *color {
+	if c == nil {
+		return nil
+	}
+	return &color{R: c.R, G: c.G, B: c.B, A: c.A}
+}
+
+func (s *signalID) proto() *pb.SignalID {
+	if s == nil {
+		return nil
+	}
+	return &pb.SignalID{Type: s.Type, Name: s.Name}
+}
+
+func fromSignalID(s *pb.SignalID) *signalID {
+	if s == nil {
+		return nil
+	}
+	return &signalID{Type: s.Type, Name: s.Name}
+}
+
+func iconsProto(icons []icon) []*pb.Icon {
+	if len(icons) == 0 {
+		return nil
+	}
+	v := make([]*pb.Icon, len(icons))
+	for i, icon := range icons {
+		v[i] = &pb.Icon{
+			Index:  icon.Index,
+			Signal: icon.Signal.proto(),
+		}
+	}
+	return v
+}
+
+func fromIcons(icons []*pb.Icon) []icon {
+	if len(icons) == 0 {
+		return nil
+	}
+	v := make([]icon, len(icons))
+	for i, ic := range icons {
+		v[i] = icon{
+			Index:  ic.Index,
+			Signal: fromSignalID(ic.Signal),
+		}
+	}
+	return v
+}
+
+func tilesProto(tiles []tile) []*pb.Tile {
+	if len(tiles) == 0 {
+		return nil
+	}
+	v := make([]*pb.Tile, len(tiles))
+	for i, t := range tiles {
+		v[i] = &pb.Tile{
+			Name:     t.Name,
+			Position: t.Position.proto(),
+		}
+	}
+	return v
+}
+
+func fromTiles(tiles []*pb.Tile) []tile {
+	if len(tiles) == 0 {
+		return nil
+	}
+	v := make([]tile, len(tiles))
+	for i, t := range tiles {
+		v[i] = tile{
+			Name:     t.Name,
+			Position: fromPosition(t.Position),
+		}
+	}
+	return v
+}
+
+func circuitConnectionsProto(c []circuitConnection) []*pb.Entity_Connection {
+	if len(c) == 0 {
+		return nil
+	}
+	v := make([]*pb.Entity_Connection, len(c))
+	for i, c := range c {
+		v[i] = &pb.Entity_Connection{
+			EntityId:  c.EntityID,
+			CircuitId: c.CircuitID,
+		}
+	}
+	return v
+}
+
+func fromCircuitConnections(c []*pb.Entity_Connection) []circuitConnection {
+	if len(c) == 0 {
+		return nil
+	}
+	v := make([]circuitConnection, len(c))
+	for i, c := range c {
+		v[i] = circuitConnection{
+			EntityID:  c.EntityId,
+			CircuitID: c.CircuitId,
+		}
+	}
+	return v
+}
+
+func copperConnectionsProto(c []copperConnection) []*pb.Entity_Connection {
+	if len(c) == 0 {
+		return nil
+	}
+	v := make([]*pb.Entity_Connection, len(c))
+	for i, c := range c {
+		v[i] = &pb.Entity_Connection{
+			EntityId: c.EntityID,
+			WireId:   c.WireID,
+		}
+	}
+	return v
+}
+
+func fromCopperConnections(c []*pb.Entity_Connection) []copperConnection {
+	if len(c) == 0 {
+		return nil
+	}
+	v := make([]copperConnection, len(c))
+	for i, c := range c {
+		v[i] = copperConnection{
+			EntityID: c.EntityId,
+			WireID:   c.WireId,
+		}
+	}
+	return v
+}
+
+func (p *connectionPoint) proto() *pb.Entity_ConnectionPoint {
+	if p == nil {
+		return nil
+	}
+	return &pb.Entity_ConnectionPoint{
+		Red:   circuitConnectionsProto(p.Red),
+		Green: circuitConnectionsProto(p.Green),
+	}
+}
+
+func fromConnectionPoint(p *pb.Entity_ConnectionPoint) *connectionPoint {
+	if p == nil {
+		return nil
+	}
+	return &connectionPoint{
+		Red:   fromCircuitConnections(p.Red),
+		Green: fromCircuitConnections(p.Green),
+	}
+}
+
+func (c *connections) proto() *pb.Entity_Connections {
+	if c == nil {
+		return nil
+	}
+	return &pb.Entity_Connections{
+		Point_1: c.Point1.proto(),
+		Point_2: c.Point2.proto(),
+		Cu0:     copperConnectionsProto(c.Cu0),
+		Cu1:     copperConnectionsProto(c.Cu1),
+	}
+}
+
+func fromConnections(c *pb.Entity_Connections) *connections {
+	if c == nil {
+		return nil
+	}
+	return &connections{
+		Point1: fromConnectionPoint(c.Point_1),
+		Point2: fromConnectionPoint(c.Point_2),
+		Cu0:    fromCopperConnections(c.Cu0),
+		Cu1:    fromCopperConnections(c.Cu1),
+	}
+}
+
+func (e *entity) proto() (*pb.Entity, error) {
+	controlBehavior, err := toStruct(e.ControlBehavior)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Entity{
+		EntityNumber:    e.EntityNumber,
+		Name:            e.Name,
+		Position:        e.Position.proto(),
+		Direction:       e.Direction,
+		Items:           e.Items,
+		Recipe:          e.Recipe,
+		ControlBehavior: controlBehavior,
+		Connections:     e.Connections.proto(),
+		Extra:           e.Extra,
+	}, nil
+}
+
+func fromEntity(e *pb.Entity) (*entity, error) {
+	controlBehavior, err := fromStruct(e.ControlBehavior)
+	if err != nil {
+		return nil, err
+	}
+	return &entity{
+		EntityNumber:    e.EntityNumber,
+		Name:            e.Name,
+		Position:        fromPosition(e.Position),
+		Direction:       e.Direction,
+		Items:           e.Items,
+		Recipe:          e.Recipe,
+		ControlBehavior: controlBehavior,
+		Connections:     fromConnections(e.Connections),
+		Extra:           e.Extra,
+	}, nil
+}
+
+func (b *blueprint) proto() (*pb.Blueprint, error) {
+	var entities []*pb.Entity
+	if len(b.Entities) > 0 {
+		entities = make([]*pb.Entity, len(b.Entities))
+	}
+	for i := range b.Entities {
+		e, err := b.Entities[i].proto()
+		if err != nil {
+			return nil, err
+		}
+		entities[i] = e
+	}
+	return &pb.Blueprint{
+		Version:                b.Version,
+		Item:                   b.Item,
+		Label:                  b.Label,
+		LabelColor:             b.LabelColor.proto(),
+		Icons:                  iconsProto(b.Icons),
+		Entities:               entities,
+		Tiles:                  tilesProto(b.Tiles),
+		Description:            b.Description,
+		SnapToGrid:             b.SnapToGrid.proto(),
+		AbsoluteSnapping:       b.AbsoluteSnapping,
+		PositionRelativeToGrid: b.PositionRelativeToGrid.proto(),
+		GameVersion:            Version(b.Version).Proto(),
+		Extra:                  b.Extra,
+	}, nil
+}
+
+func fromBlueprint(b *pb.Blueprint) (*blueprint, error) {
+	if b == nil {
+		return nil, nil
+	}
+	var entities []entity
+	if len(b.Entities) > 0 {
+		entities = make([]entity, len(b.Entities))
+	}
+	for i, e := range b.Entities {
+		v, err := fromEntity(e)
+		if err != nil {
+			return nil, err
+		}
+		entities[i] = *v
+	}
+	return &blueprint{
+		Item:                   b.Item,
+		Label:                  b.Label,
+		LabelColor:             fromColor(b.LabelColor),
+		Description:            b.Description,
+		Icons:                  fromIcons(b.Icons),
+		Entities:               entities,
+		Tiles:                  fromTiles(b.Tiles),
+		SnapToGrid:             fromPosition(b.SnapToGrid),
+		AbsoluteSnapping:       b.AbsoluteSnapping,
+		PositionRelativeToGrid: fromPosition(b.PositionRelativeToGrid),
+		Version:                b.Version,
+		Extra:                  b.Extra,
+	}, nil
+}
+
+func (b *blueprintBook) proto() (*pb.BlueprintBook, error) {
+	entries := make([]*pb.BlueprintBookEntry, 0, len(b.Blueprints))
+	for i := range b.Blueprints {
+		e := &b.Blueprints[i]
+		item, err := e.object.proto()
+		if err == ErrUnrecognized {
+			// The unrecognized object is in the Extra of the entry.
+			item = &pb.Item{}
+		} else if err != nil {
+			return nil, err
+		}
+		entry := Entry(e.Index, item)
+		entry.Extra = e.Extra
+		entries = append(entries, entry)
+	}
+	return &pb.BlueprintBook{
+		Version:     b.Version,
+		Item:        b.Item,
+		Label:       b.Label,
+		LabelColor:  b.LabelColor.proto(),
+		Icons:       iconsProto(b.Icons),
+		ActiveIndex: b.ActiveIndex,
+		Blueprints:  entries,
+		Description: b.Description,
+		GameVersion: Version(b.Version).Proto(),
+		Extra:       b.Extra,
+	}, nil
+}
+
+func fromBlueprintBook(b *pb.BlueprintBook) (*blueprintBook, error) {
+	entries := make([]blueprintBookEntry, len(b.Blueprints))
+	for i, e := range b.Blueprints {
+		entries[i] = blueprintBookEntry{
+			Index: e.Index,
+			Extra: e.Extra,
+		}
+		if e.Entry == nil {
+			continue
+		}
+		v, err := fromItem(EntryItem(e))
+		if err != nil {
+			return nil, err
+		}
+		entries[i].object = *v
+	}
+	return &blueprintBook{
+		Item:        b.Item,
+		Label:       b.Label,
+		LabelColor:  fromColor(b.LabelColor),
+		Description: b.Description,
+		Icons:       fromIcons(b.Icons),
+		ActiveIndex: b.ActiveIndex,
+		Blueprints:  entries,
+		Version:     b.Version,
+		Extra:       b.Extra,
+	}, nil
+}
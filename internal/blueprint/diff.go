@@ -0,0 +1,209 @@
+package blueprint
+
+import (
+	pb "api.fabl.app/pb/fabl/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type point struct {
+	x, y float64
+}
+
+func pointOf(p *pb.Position) point {
+	return point{p.GetX(), p.GetY()}
+}
+
+// configKey returns a key equal for entities configured the same, ignoring
+// their position, entity number and wires, as wires refer to entity numbers.
+func configKey(e *pb.Entity) string {
+	e = proto.Clone(e).(*pb.Entity)
+	e.EntityNumber = 0
+	e.Position = nil
+	e.Connections = nil
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(e)
+	return string(b)
+}
+
+// IconsEqual reports whether a and b hold the same icons.
+func IconsEqual(a, b []*pb.Icon) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffBlueprints compares the entities and tiles of two blueprints. Entities
+// are matched by name and position first, then by name and configuration.
+func DiffBlueprints(from, to *pb.Blueprint) *pb.DiffResponse_BlueprintDiff {
+	d := &pb.DiffResponse_BlueprintDiff{}
+
+	type key struct {
+		name string
+		p    point
+	}
+	var (
+		byKey     = make(map[key][]*pb.Entity)
+		configs   = make(map[*pb.Entity]string)
+		matched   = make(map[*pb.Entity]bool)
+		unmatched []*pb.Entity
+	)
+	for _, e := range from.Entities {
+		k := key{e.Name, pointOf(e.Position)}
+		byKey[k] = append(byKey[k], e)
+		configs[e] = configKey(e)
+	}
+	for _, e := range to.Entities {
+		configs[e] = configKey(e)
+		k := key{e.Name, pointOf(e.Position)}
+		if len(byKey[k]) == 0 {
+			unmatched = append(unmatched, e)
+			continue
+		}
+		old := byKey[k][0]
+		byKey[k] = byKey[k][1:]
+		matched[old] = true
+		if configs[old] != configs[e] {
+			d.ReconfiguredEntities = append(d.ReconfiguredEntities, &pb.DiffResponse_EntityChange{From: old, To: e})
+		}
+	}
+	byConfig := make(map[string][]*pb.Entity)
+	for _, e := range from.Entities {
+		if !matched[e] {
+			byConfig[configs[e]] = append(byConfig[configs[e]], e)
+		}
+	}
+	for _, e := range unmatched {
+		olds := byConfig[configs[e]]
+		if len(olds) == 0 {
+			d.AddedEntities = append(d.AddedEntities, e)
+			continue
+		}
+		old := olds[0]
+		byConfig[configs[e]] = olds[1:]
+		matched[old] = true
+		d.MovedEntities = append(d.MovedEntities, &pb.DiffResponse_EntityChange{From: old, To: e})
+	}
+	for _, e := range from.Entities {
+		if !matched[e] {
+			d.RemovedEntities = append(d.RemovedEntities, e)
+		}
+	}
+
+	tiles := make(map[point]*pb.Tile)
+	for _, t := range from.Tiles {
+		tiles[pointOf(t.Position)] = t
+	}
+	for _, t := range to.Tiles {
+		p := pointOf(t.Position)
+		old, ok := tiles[p]
+		if !ok {
+			d.AddedTiles = append(d.AddedTiles, t)
+			continue
+		}
+		delete(tiles, p)
+		if old.Name != t.Name {
+			d.ChangedTiles = append(d.ChangedTiles, &pb.DiffResponse_TileChange{From: old, To: t})
+		}
+	}
+	for _, t := range from.Tiles {
+		if tiles[pointOf(t.Position)] == t {
+			d.RemovedTiles = append(d.RemovedTiles, t)
+		}
+	}
+	return d
+}
+
+type page struct {
+	index   uint64
+	item    *pb.Item
+	kind    string
+	label   string
+	icons   []*pb.Icon
+	content string
+}
+
+func pages(b *pb.BlueprintBook) []*page {
+	v := make([]*page, len(b.Blueprints))
+	for i, e := range b.Blueprints {
+		item := EntryItem(e)
+		kind, label, icons := Summary(item)
+		content, _ := proto.MarshalOptions{Deterministic: true}.Marshal(item)
+		v[i] = &page{e.Index, item, kind, label, icons, string(content)}
+	}
+	return v
+}
+
+func pageChange(t pb.DiffResponse_PageChange_Type, from, to *page) *pb.DiffResponse_PageChange {
+	c := &pb.DiffResponse_PageChange{Type: t}
+	if from != nil {
+		c.FromIndex, c.FromLabel = from.index, from.label
+	}
+	if to != nil {
+		c.ToIndex, c.ToLabel = to.index, to.label
+	}
+	if from != nil && to != nil {
+		c.IconsChanged = !IconsEqual(from.icons, to.icons)
+	}
+	return c
+}
+
+// DiffBooks compares the pages of two blueprint books. Pages are matched by
+// content first, then by kind and label, and finally by kind and index.
+func DiffBooks(from, to *pb.BlueprintBook) []*pb.DiffResponse_PageChange {
+	var (
+		changes    []*pb.DiffResponse_PageChange
+		olds, news = pages(from), pages(to)
+		matched    = make(map[*page]bool)
+	)
+	match := func(same func(a, b *page) bool, change func(a, b *page)) {
+		for _, b := range news {
+			if matched[b] {
+				continue
+			}
+			for _, a := range olds {
+				if !matched[a] && same(a, b) {
+					matched[a], matched[b] = true, true
+					change(a, b)
+					break
+				}
+			}
+		}
+	}
+	moved := func(a, b *page) {
+		if a.index != b.index {
+			changes = append(changes, pageChange(pb.DiffResponse_PageChange_TYPE_MOVED, a, b))
+		}
+	}
+	modified := func(a, b *page) {
+		c := pageChange(pb.DiffResponse_PageChange_TYPE_MODIFIED, a, b)
+		if a.kind == KindBlueprint {
+			c.Blueprint = DiffBlueprints(a.item.GetBlueprint(), b.item.GetBlueprint())
+		}
+		changes = append(changes, c)
+	}
+	match(func(a, b *page) bool {
+		return a.content == b.content
+	}, moved)
+	match(func(a, b *page) bool {
+		return a.kind == b.kind && a.label != "" && a.label == b.label
+	}, modified)
+	match(func(a, b *page) bool {
+		return a.kind == b.kind && a.index == b.index
+	}, modified)
+	for _, a := range olds {
+		if !matched[a] {
+			changes = append(changes, pageChange(pb.DiffResponse_PageChange_TYPE_REMOVED, a, nil))
+		}
+	}
+	for _, b := range news {
+		if !matched[b] {
+			changes = append(changes, pageChange(pb.DiffResponse_PageChange_TYPE_INSERTED, nil, b))
+		}
+	}
+	return changes
+}
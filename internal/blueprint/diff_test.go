@@ -0,0 +1,180 @@
+package blueprint
+
+import (
+	"testing"
+
+	pb "api.fabl.app/pb/fabl/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func newEntity(number uint32, name string, x, y float64) *pb.Entity {
+	return &pb.Entity{EntityNumber: number, Name: name, Position: &pb.Position{X: x, Y: y}}
+}
+
+func newTile(name string, x, y float64) *pb.Tile {
+	return &pb.Tile{Name: name, Position: &pb.Position{X: x, Y: y}}
+}
+
+func TestDiffBlueprints(t *testing.T) {
+	var (
+		chest      = newEntity(1, "wooden-chest", 0.5, 0.5)
+		chest2     = newEntity(2, "wooden-chest", 1.5, 0.5)
+		chest3     = newEntity(3, "wooden-chest", 2.5, 0.5)
+		movedChest = newEntity(1, "wooden-chest", 5.5, 0.5)
+		gears      = &pb.Entity{EntityNumber: 2, Name: "assembling-machine-1", Position: &pb.Position{X: 3.5, Y: 3.5}, Recipe: "iron-gear-wheel"}
+		wires      = &pb.Entity{EntityNumber: 2, Name: "assembling-machine-1", Position: &pb.Position{X: 3.5, Y: 3.5}, Recipe: "copper-cable"}
+		inserter   = &pb.Entity{EntityNumber: 3, Name: "inserter", Position: &pb.Position{X: 1.5, Y: 3.5}, Direction: 2}
+		concrete   = newTile("concrete", 0, 0)
+		stonePath  = newTile("stone-path", 0, 0)
+		concrete2  = newTile("concrete", 1, 0)
+	)
+	renumbered := proto.Clone(gears).(*pb.Entity)
+	renumbered.EntityNumber = 7
+	renumbered.Connections = &pb.Entity_Connections{}
+	var shifted, shiftedBy1 []*pb.Entity
+	for i := 0; i < 100; i++ {
+		shifted = append(shifted, newEntity(uint32(i+1), "transport-belt", float64(i)+0.5, 0.5))
+		shiftedBy1 = append(shiftedBy1, newEntity(uint32(i+1), "transport-belt", float64(i)+0.5, 1.5))
+	}
+	shiftedDiff := &pb.DiffResponse_BlueprintDiff{}
+	for i := range shifted {
+		shiftedDiff.MovedEntities = append(shiftedDiff.MovedEntities, &pb.DiffResponse_EntityChange{From: shifted[i], To: shiftedBy1[i]})
+	}
+
+	tests := []struct {
+		name     string
+		from, to *pb.Blueprint
+		want     *pb.DiffResponse_BlueprintDiff
+	}{
+		{"unchanged",
+			&pb.Blueprint{Entities: []*pb.Entity{chest, gears}, Tiles: []*pb.Tile{concrete}},
+			&pb.Blueprint{Entities: []*pb.Entity{chest, gears}, Tiles: []*pb.Tile{concrete}},
+			&pb.DiffResponse_BlueprintDiff{}},
+		{"added",
+			&pb.Blueprint{Entities: []*pb.Entity{chest}},
+			&pb.Blueprint{Entities: []*pb.Entity{chest, inserter}},
+			&pb.DiffResponse_BlueprintDiff{AddedEntities: []*pb.Entity{inserter}}},
+		{"removed",
+			&pb.Blueprint{Entities: []*pb.Entity{chest, inserter}},
+			&pb.Blueprint{Entities: []*pb.Entity{chest}},
+			&pb.DiffResponse_BlueprintDiff{RemovedEntities: []*pb.Entity{inserter}}},
+		{"moved",
+			&pb.Blueprint{Entities: []*pb.Entity{chest, gears}},
+			&pb.Blueprint{Entities: []*pb.Entity{movedChest, gears}},
+			&pb.DiffResponse_BlueprintDiff{MovedEntities: []*pb.DiffResponse_EntityChange{{From: chest, To: movedChest}}}},
+		{"moved past identical entities",
+			&pb.Blueprint{Entities: []*pb.Entity{chest, chest2}},
+			&pb.Blueprint{Entities: []*pb.Entity{chest2, chest3}},
+			&pb.DiffResponse_BlueprintDiff{MovedEntities: []*pb.DiffResponse_EntityChange{{From: chest, To: chest3}}}},
+		{"shifted by one tile",
+			&pb.Blueprint{Entities: shifted},
+			&pb.Blueprint{Entities: shiftedBy1},
+			shiftedDiff},
+		{"reconfigured",
+			&pb.Blueprint{Entities: []*pb.Entity{chest, gears}},
+			&pb.Blueprint{Entities: []*pb.Entity{chest, wires}},
+			&pb.DiffResponse_BlueprintDiff{ReconfiguredEntities: []*pb.DiffResponse_EntityChange{{From: gears, To: wires}}}},
+		{"renumbered and rewired",
+			&pb.Blueprint{Entities: []*pb.Entity{gears}},
+			&pb.Blueprint{Entities: []*pb.Entity{renumbered}},
+			&pb.DiffResponse_BlueprintDiff{}},
+		{"replaced",
+			&pb.Blueprint{Entities: []*pb.Entity{chest}},
+			&pb.Blueprint{Entities: []*pb.Entity{newEntity(1, "iron-chest", 0.5, 0.5)}},
+			&pb.DiffResponse_BlueprintDiff{
+				AddedEntities:   []*pb.Entity{newEntity(1, "iron-chest", 0.5, 0.5)},
+				RemovedEntities: []*pb.Entity{chest},
+			}},
+		{"tiles",
+			&pb.Blueprint{Tiles: []*pb.Tile{concrete, newTile("concrete", 2, 0)}},
+			&pb.Blueprint{Tiles: []*pb.Tile{stonePath, concrete2}},
+			&pb.DiffResponse_BlueprintDiff{
+				AddedTiles:   []*pb.Tile{concrete2},
+				RemovedTiles: []*pb.Tile{newTile("concrete", 2, 0)},
+				ChangedTiles: []*pb.DiffResponse_TileChange{{From: concrete, To: stonePath}},
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffBlueprints(tt.from, tt.to)
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("DiffBlueprints = %v\nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffBooks(t *testing.T) {
+	blueprint := func(label string, entities ...*pb.Entity) *pb.Item {
+		return &pb.Item{Item: &pb.Item_Blueprint{Blueprint: &pb.Blueprint{Label: label, Entities: entities}}}
+	}
+	planner := &pb.Item{Item: &pb.Item_DeconstructionPlanner{DeconstructionPlanner: &pb.DeconstructionPlanner{}}}
+	book := func(items ...*pb.Item) *pb.BlueprintBook {
+		b := &pb.BlueprintBook{}
+		for i, item := range items {
+			if item != nil {
+				b.Blueprints = append(b.Blueprints, Entry(uint64(i), item))
+			}
+		}
+		return b
+	}
+	var (
+		chest      = newEntity(1, "wooden-chest", 0.5, 0.5)
+		inserter   = newEntity(2, "inserter", 1.5, 0.5)
+		smelting   = blueprint("Smelting", chest)
+		smelting2  = blueprint("Smelting", chest, inserter)
+		unlabeled  = blueprint("", chest)
+		unlabeled2 = blueprint("", chest, inserter)
+	)
+	withIcon := proto.Clone(smelting).(*pb.Item)
+	withIcon.GetBlueprint().Icons = []*pb.Icon{{Index: 1, Signal: &pb.SignalID{Type: "item", Name: "stone-furnace"}}}
+	added := &pb.DiffResponse_BlueprintDiff{AddedEntities: []*pb.Entity{inserter}}
+
+	tests := []struct {
+		name     string
+		from, to *pb.BlueprintBook
+		want     []*pb.DiffResponse_PageChange
+	}{
+		{"unchanged", book(smelting, planner), book(smelting, planner), nil},
+		{"matched by content", book(smelting, planner), book(planner, nil, smelting), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_MOVED, FromIndex: 1, ToIndex: 0},
+			{Type: pb.DiffResponse_PageChange_TYPE_MOVED, FromIndex: 0, ToIndex: 2, FromLabel: "Smelting", ToLabel: "Smelting"},
+		}},
+		{"matched by label", book(smelting), book(nil, smelting2), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_MODIFIED, FromIndex: 0, ToIndex: 1, FromLabel: "Smelting", ToLabel: "Smelting", Blueprint: added},
+		}},
+		{"icons changed", book(smelting), book(withIcon), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_MODIFIED, FromLabel: "Smelting", ToLabel: "Smelting", IconsChanged: true, Blueprint: &pb.DiffResponse_BlueprintDiff{}},
+		}},
+		{"matched by index", book(nil, unlabeled), book(nil, unlabeled2), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_MODIFIED, FromIndex: 1, ToIndex: 1, Blueprint: added},
+		}},
+		{"unlabeled pages not matched by label", book(unlabeled), book(nil, unlabeled2), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_REMOVED, FromIndex: 0},
+			{Type: pb.DiffResponse_PageChange_TYPE_INSERTED, ToIndex: 1},
+		}},
+		{"kind changed", book(unlabeled), book(planner), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_REMOVED, FromIndex: 0},
+			{Type: pb.DiffResponse_PageChange_TYPE_INSERTED, ToIndex: 0},
+		}},
+		{"inserted", book(smelting), book(smelting, planner), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_INSERTED, ToIndex: 1},
+		}},
+		{"removed", book(smelting, planner), book(smelting), []*pb.DiffResponse_PageChange{
+			{Type: pb.DiffResponse_PageChange_TYPE_REMOVED, FromIndex: 1},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffBooks(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DiffBooks = %v\nwant %v", got, tt.want)
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("change %d = %v\nwant %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package blueprint
+
+// Prototype describes an entity of the base game.
+type Prototype struct {
+	// Type is the prototype type, e.g. "transport-belt" or "assembling-machine".
+	Type string
+	// Width and Height of the entity in tiles, when facing north.
+	Width, Height int
+}
+
+// Prototypes holds the blueprintable entities of the base game, by name.
+var Prototypes = map[string]Prototype{
+	"transport-belt":           {"transport-belt", 1, 1},
+	"fast-transport-belt":      {"transport-belt", 1, 1},
+	"express-transport-belt":   {"transport-belt", 1, 1},
+	"underground-belt":         {"underground-belt", 1, 1},
+	"fast-underground-belt":    {"underground-belt", 1, 1},
+	"express-underground-belt": {"underground-belt", 1, 1},
+	"splitter":                 {"splitter", 2, 1},
+	"fast-splitter":            {"splitter", 2, 1},
+	"express-splitter":         {"splitter", 2, 1},
+	"loader":                   {"loader", 1, 2},
+	"fast-loader":              {"loader", 1, 2},
+	"express-loader":           {"loader", 1, 2},
+
+	"burner-inserter":       {"inserter", 1, 1},
+	"inserter":              {"inserter", 1, 1},
+	"long-handed-inserter":  {"inserter", 1, 1},
+	"fast-inserter":         {"inserter", 1, 1},
+	"filter-inserter":       {"inserter", 1, 1},
+	"stack-inserter":        {"inserter", 1, 1},
+	"stack-filter-inserter": {"inserter", 1, 1},
+
+	"small-electric-pole":  {"electric-pole", 1, 1},
+	"medium-electric-pole": {"electric-pole", 1, 1},
+	"big-electric-pole":    {"electric-pole", 2, 2},
+	"substation":           {"electric-pole", 2, 2},
+
+	"pipe":           {"pipe", 1, 1},
+	"pipe-to-ground": {"pipe-to-ground", 1, 1},
+	"pump":           {"pump", 1, 2},
+	"offshore-pump":  {"offshore-pump", 1, 2},
+	"storage-tank":   {"storage-tank", 3, 3},
+
+	"straight-rail":     {"straight-rail", 2, 2},
+	"curved-rail":       {"curved-rail", 4, 8},
+	"train-stop":        {"train-stop", 2, 2},
+	"rail-signal":       {"rail-signal", 1, 1},
+	"rail-chain-signal": {"rail-chain-signal", 1, 1},
+	"locomotive":        {"locomotive", 2, 6},
+	"cargo-wagon":       {"cargo-wagon", 2, 6},
+	"fluid-wagon":       {"fluid-wagon", 2, 6},
+	"artillery-wagon":   {"artillery-wagon", 2, 6},
+
+	"wooden-chest":                    {"container", 1, 1},
+	"iron-chest":                      {"container", 1, 1},
+	"steel-chest":                     {"container", 1, 1},
+	"logistic-chest-active-provider":  {"logistic-container", 1, 1},
+	"logistic-chest-passive-provider": {"logistic-container", 1, 1},
+	"logistic-chest-storage":          {"logistic-container", 1, 1},
+	"logistic-chest-buffer":           {"logistic-container", 1, 1},
+	"logistic-chest-requester":        {"logistic-container", 1, 1},
+
+	"boiler":          {"boiler", 3, 2},
+	"steam-engine":    {"generator", 3, 5},
+	"solar-panel":     {"solar-panel", 3, 3},
+	"accumulator":     {"accumulator", 2, 2},
+	"nuclear-reactor": {"reactor", 5, 5},
+	"heat-pipe":       {"heat-pipe", 1, 1},
+	"heat-exchanger":  {"boiler", 3, 2},
+	"steam-turbine":   {"generator", 3, 5},
+
+	"burner-mining-drill":   {"mining-drill", 2, 2},
+	"electric-mining-drill": {"mining-drill", 3, 3},
+	"pumpjack":              {"mining-drill", 3, 3},
+
+	"stone-furnace":    {"furnace", 2, 2},
+	"steel-furnace":    {"furnace", 2, 2},
+	"electric-furnace": {"furnace", 3, 3},
+
+	"assembling-machine-1": {"assembling-machine", 3, 3},
+	"assembling-machine-2": {"assembling-machine", 3, 3},
+	"assembling-machine-3": {"assembling-machine", 3, 3},
+	"oil-refinery":         {"assembling-machine", 5, 5},
+	"chemical-plant":       {"assembling-machine", 3, 3},
+	"centrifuge":           {"assembling-machine", 3, 3},
+	"lab":                  {"lab", 3, 3},
+	"beacon":               {"beacon", 3, 3},
+	"rocket-silo":          {"rocket-silo", 9, 9},
+
+	"arithmetic-combinator": {"arithmetic-combinator", 1, 2},
+	"decider-combinator":    {"decider-combinator", 1, 2},
+	"constant-combinator":   {"constant-combinator", 1, 1},
+	"power-switch":          {"power-switch", 2, 2},
+	"programmable-speaker":  {"programmable-speaker", 1, 1},
+	"small-lamp":            {"lamp", 1, 1},
+
+	"stone-wall":          {"wall", 1, 1},
+	"gate":                {"gate", 1, 1},
+	"gun-turret":          {"ammo-turret", 2, 2},
+	"laser-turret":        {"electric-turret", 2, 2},
+	"flamethrower-turret": {"fluid-turret", 2, 3},
+	"artillery-turret":    {"artillery-turret", 3, 3},
+	"radar":               {"radar", 3, 3},
+	"land-mine":           {"land-mine", 1, 1},
+	"roboport":            {"roboport", 4, 4},
+}
@@ -0,0 +1,89 @@
+package blueprint
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// unknownFields returns the keys of the JSON object in data that don't match
+// any json tag of the struct v points to, or of the structs it embeds.
+func unknownFields(data []byte, v interface{}) (*structpb.Struct, error) {
+	var m map[string]json.RawMessage
+	err := json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	deleteFields(m, reflect.TypeOf(v).Elem())
+	if len(m) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return toStruct(b)
+}
+
+func deleteFields(m map[string]json.RawMessage, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			deleteFields(m, f.Type)
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		delete(m, name)
+	}
+}
+
+// withUnknownFields marshals v and adds the fields in extra to the resulting
+// JSON object.
+func withUnknownFields(v interface{}, extra *structpb.Struct) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra.GetFields()) == 0 {
+		return data, err
+	}
+	var m map[string]json.RawMessage
+	err = json.Unmarshal(data, &m)
+	if err != nil {
+		return nil, err
+	}
+	b, err := fromStruct(extra)
+	if err != nil {
+		return nil, err
+	}
+	var e map[string]json.RawMessage
+	err = json.Unmarshal(b, &e)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range e {
+		if _, ok := m[k]; !ok {
+			m[k] = v
+		}
+	}
+	return json.Marshal(m)
+}
+
+func toStruct(data []byte) (*structpb.Struct, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	s := &structpb.Struct{}
+	err := protojson.Unmarshal(data, s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func fromStruct(s *structpb.Struct) (json.RawMessage, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return protojson.Marshal(s)
+}
@@ -0,0 +1,72 @@
+package blueprint
+
+import (
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+type placeable struct {
+	item  string
+	count uint64
+}
+
+// placedBy lists the entities and tiles not placed by a single item of the
+// same name.
+var placedBy = map[string]placeable{
+	"straight-rail":                 {"rail", 1},
+	"curved-rail":                   {"rail", 4},
+	"stone-path":                    {"stone-brick", 1},
+	"hazard-concrete-left":          {"hazard-concrete", 1},
+	"hazard-concrete-right":         {"hazard-concrete", 1},
+	"refined-hazard-concrete-left":  {"refined-hazard-concrete", 1},
+	"refined-hazard-concrete-right": {"refined-hazard-concrete", 1},
+}
+
+// Materials counts the items needed to build blueprints.
+type Materials struct {
+	// Items maps item names to the number needed.
+	Items map[string]uint64
+	// Entities, Tiles and Modules are the number of entities and tiles
+	// placed, and modules (or other items) inserted into entities.
+	Entities uint64
+	Tiles    uint64
+	Modules  uint64
+}
+
+func (m *Materials) add(name string, n uint64) {
+	if m.Items == nil {
+		m.Items = make(map[string]uint64)
+	}
+	if p, ok := placedBy[name]; ok {
+		name, n = p.item, n*p.count
+	}
+	m.Items[name] += n
+}
+
+// AddBlueprint adds everything needed to build b.
+func (m *Materials) AddBlueprint(b *pb.Blueprint) {
+	for _, e := range b.Entities {
+		m.add(e.Name, 1)
+		m.Entities++
+		for name, n := range e.Items {
+			m.add(name, uint64(n))
+			m.Modules += uint64(n)
+		}
+	}
+	for _, t := range b.Tiles {
+		m.add(t.Name, 1)
+		m.Tiles++
+	}
+}
+
+// Add adds the materials counted in o.
+func (m *Materials) Add(o *Materials) {
+	for name, n := range o.Items {
+		if m.Items == nil {
+			m.Items = make(map[string]uint64)
+		}
+		m.Items[name] += n
+	}
+	m.Entities += o.Entities
+	m.Tiles += o.Tiles
+	m.Modules += o.Modules
+}
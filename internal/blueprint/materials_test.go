@@ -0,0 +1,124 @@
+package blueprint
+
+import (
+	"reflect"
+	"testing"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+func TestAddBlueprint(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want Materials
+	}{
+		{"empty blueprint", `{"blueprint":{"item":"blueprint","version":1}}`, Materials{}},
+		{"entities", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":0.5}},
+			{"entity_number":2,"name":"inserter","position":{"x":1.5,"y":0.5}},
+			{"entity_number":3,"name":"wooden-chest","position":{"x":2.5,"y":0.5}}]}}`,
+			Materials{Items: map[string]uint64{"wooden-chest": 2, "inserter": 1}, Entities: 3}},
+		{"rails", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"straight-rail","position":{"x":1,"y":1}},
+			{"entity_number":2,"name":"straight-rail","position":{"x":3,"y":1}},
+			{"entity_number":3,"name":"curved-rail","position":{"x":6,"y":3}}]}}`,
+			Materials{Items: map[string]uint64{"rail": 6}, Entities: 3}},
+		{"tiles", `{"blueprint":{"item":"blueprint","version":1,"tiles":[
+			{"name":"stone-path","position":{"x":0,"y":0}},
+			{"name":"stone-path","position":{"x":1,"y":0}},
+			{"name":"concrete","position":{"x":2,"y":0}},
+			{"name":"hazard-concrete-left","position":{"x":3,"y":0}},
+			{"name":"hazard-concrete-right","position":{"x":4,"y":0}}]}}`,
+			Materials{Items: map[string]uint64{"stone-brick": 2, "concrete": 1, "hazard-concrete": 2}, Tiles: 5}},
+		{"modules and item requests", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"assembling-machine-2","position":{"x":1.5,"y":1.5},"items":{"speed-module":2}},
+			{"entity_number":2,"name":"beacon","position":{"x":4.5,"y":1.5},"items":{"speed-module":2}},
+			{"entity_number":3,"name":"boiler","position":{"x":8,"y":1.5},"items":{"coal":5}}]}}`,
+			Materials{
+				Items: map[string]uint64{
+					"assembling-machine-2": 1, "beacon": 1, "boiler": 1, "speed-module": 4, "coal": 5,
+				},
+				Entities: 3,
+				Modules:  9,
+			}},
+		{"entities and tiles", "testdata/blueprint.json", Materials{
+			Items: map[string]uint64{
+				"stone-furnace": 1, "burner-inserter": 1, "transport-belt": 1,
+				"medium-electric-pole": 1, "constant-combinator": 1, "assembling-machine-2": 1,
+				"arithmetic-combinator": 1, "small-electric-pole": 1, "power-switch": 1,
+				"speed-module": 2, "stone-brick": 2, "concrete": 1,
+			},
+			Entities: 9,
+			Tiles:    3,
+			Modules:  2,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(tt.data)
+			if tt.data[0] != '{' {
+				data = readFile(t, tt.data)
+			}
+			item, err := Decode(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var m Materials
+			m.AddBlueprint(item.GetBlueprint())
+			if !reflect.DeepEqual(m, tt.want) {
+				t.Errorf("AddBlueprint = %+v, want %+v", m, tt.want)
+			}
+		})
+	}
+}
+
+// TestAddBook adds the materials of every blueprint of a book, as the
+// BillOfMaterials RPC does.
+func TestAddBook(t *testing.T) {
+	item, err := Decode([]byte(`{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+		{"index":0,"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":0.5}},
+			{"entity_number":2,"name":"wooden-chest","position":{"x":1.5,"y":0.5}}]}},
+		{"index":1,"deconstruction_planner":{"item":"deconstruction-planner","version":1}},
+		{"index":2,"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","version":1,
+				"entities":[
+					{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":0.5}},
+					{"entity_number":2,"name":"beacon","position":{"x":3.5,"y":0.5},"items":{"speed-module":2}}],
+				"tiles":[
+					{"name":"stone-path","position":{"x":0,"y":0}},
+					{"name":"concrete","position":{"x":1,"y":0}}]}},
+			{"index":1,"blueprint":{"item":"blueprint","version":1}}]}}]}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		total Materials
+		pages int
+	)
+	Walk(item, func(path []uint64, item *pb.Item) {
+		b := item.GetBlueprint()
+		if b == nil {
+			return
+		}
+		var m Materials
+		m.AddBlueprint(b)
+		total.Add(&m)
+		pages++
+	})
+	want := Materials{
+		Items: map[string]uint64{
+			"wooden-chest": 3, "beacon": 1, "speed-module": 2, "stone-brick": 1, "concrete": 1,
+		},
+		Entities: 4,
+		Tiles:    2,
+		Modules:  2,
+	}
+	if pages != 3 {
+		t.Errorf("added %d blueprints, want 3", pages)
+	}
+	if !reflect.DeepEqual(total, want) {
+		t.Errorf("total = %+v, want %+v", total, want)
+	}
+}
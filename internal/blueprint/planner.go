@@ -0,0 +1,240 @@
+package blueprint
+
+import (
+	"encoding/json"
+
+	pb "api.fabl.app/pb/fabl/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type filter struct {
+	Index uint32 `json:"index"`
+	Name  string `json:"name"`
+}
+
+type deconstructionSettings struct {
+	EntityFilterMode  int32    `json:"entity_filter_mode,omitempty"`
+	EntityFilters     []filter `json:"entity_filters,omitempty"`
+	TreesAndRocksOnly bool     `json:"trees_and_rocks_only,omitempty"`
+	TileFilterMode    int32    `json:"tile_filter_mode,omitempty"`
+	TileFilters       []filter `json:"tile_filters,omitempty"`
+	TileSelectionMode int32    `json:"tile_selection_mode,omitempty"`
+	Icons             []icon   `json:"icons,omitempty"`
+	Description       string   `json:"description,omitempty"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+type deconstructionPlanner struct {
+	Item     string                  `json:"item"`
+	Label    string                  `json:"label,omitempty"`
+	Settings *deconstructionSettings `json:"settings,omitempty"`
+	Version  uint64                  `json:"version"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+type mapper struct {
+	Index uint32    `json:"index"`
+	From  *signalID `json:"from,omitempty"`
+	To    *signalID `json:"to,omitempty"`
+}
+
+type upgradeSettings struct {
+	Mappers     []mapper `json:"mappers,omitempty"`
+	Icons       []icon   `json:"icons,omitempty"`
+	Description string   `json:"description,omitempty"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+type upgradePlanner struct {
+	Item     string           `json:"item"`
+	Label    string           `json:"label,omitempty"`
+	Settings *upgradeSettings `json:"settings,omitempty"`
+	Version  uint64           `json:"version"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+func (s *deconstructionSettings) UnmarshalJSON(data []byte) error {
+	type plain deconstructionSettings
+	err := json.Unmarshal(data, (*plain)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra, err = unknownFields(data, (*plain)(s))
+	return err
+}
+
+func (s *deconstructionSettings) MarshalJSON() ([]byte, error) {
+	type plain deconstructionSettings
+	return withUnknownFields((*plain)(s), s.Extra)
+}
+
+func (d *deconstructionPlanner) UnmarshalJSON(data []byte) error {
+	type plain deconstructionPlanner
+	err := json.Unmarshal(data, (*plain)(d))
+	if err != nil {
+		return err
+	}
+	d.Extra, err = unknownFields(data, (*plain)(d))
+	return err
+}
+
+func (d *deconstructionPlanner) MarshalJSON() ([]byte, error) {
+	type plain deconstructionPlanner
+	return withUnknownFields((*plain)(d), d.Extra)
+}
+
+func (s *upgradeSettings) UnmarshalJSON(data []byte) error {
+	type plain upgradeSettings
+	err := json.Unmarshal(data, (*plain)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra, err = unknownFields(data, (*plain)(s))
+	return err
+}
+
+func (s *upgradeSettings) MarshalJSON() ([]byte, error) {
+	type plain upgradeSettings
+	return withUnknownFields((*plain)(s), s.Extra)
+}
+
+func (u *upgradePlanner) UnmarshalJSON(data []byte) error {
+	type plain upgradePlanner
+	err := json.Unmarshal(data, (*plain)(u))
+	if err != nil {
+		return err
+	}
+	u.Extra, err = unknownFields(data, (*plain)(u))
+	return err
+}
+
+func (u *upgradePlanner) MarshalJSON() ([]byte, error) {
+	type plain upgradePlanner
+	return withUnknownFields((*plain)(u), u.Extra)
+}
+
+func filtersProto(filters []filter) []*pb.DeconstructionPlanner_Filter {
+	if len(filters) == 0 {
+		return nil
+	}
+	v := make([]*pb.DeconstructionPlanner_Filter, len(filters))
+	for i, f := range filters {
+		v[i] = &pb.DeconstructionPlanner_Filter{Index: f.Index, Name: f.Name}
+	}
+	return v
+}
+
+func fromFilters(filters []*pb.DeconstructionPlanner_Filter) []filter {
+	if len(filters) == 0 {
+		return nil
+	}
+	v := make([]filter, len(filters))
+	for i, f := range filters {
+		v[i] = filter{Index: f.Index, Name: f.Name}
+	}
+	return v
+}
+
+func (d *deconstructionPlanner) proto() *pb.DeconstructionPlanner {
+	s := d.Settings
+	if s == nil {
+		s = &deconstructionSettings{}
+	}
+	return &pb.DeconstructionPlanner{
+		Version:           d.Version,
+		Item:              d.Item,
+		Label:             d.Label,
+		Icons:             iconsProto(s.Icons),
+		Description:       s.Description,
+		EntityFilterMode:  pb.DeconstructionPlanner_FilterMode(s.EntityFilterMode),
+		EntityFilters:     filtersProto(s.EntityFilters),
+		TreesAndRocksOnly: s.TreesAndRocksOnly,
+		TileFilterMode:    pb.DeconstructionPlanner_FilterMode(s.TileFilterMode),
+		TileFilters:       filtersProto(s.TileFilters),
+		TileSelectionMode: pb.DeconstructionPlanner_TileSelectionMode(s.TileSelectionMode),
+		GameVersion:       Version(d.Version).Proto(),
+		Extra:             d.Extra,
+		SettingsExtra:     s.Extra,
+	}
+}
+
+func fromDeconstructionPlanner(d *pb.DeconstructionPlanner) *deconstructionPlanner {
+	s := &deconstructionSettings{
+		EntityFilterMode:  int32(d.EntityFilterMode),
+		EntityFilters:     fromFilters(d.EntityFilters),
+		TreesAndRocksOnly: d.TreesAndRocksOnly,
+		TileFilterMode:    int32(d.TileFilterMode),
+		TileFilters:       fromFilters(d.TileFilters),
+		TileSelectionMode: int32(d.TileSelectionMode),
+		Icons:             fromIcons(d.Icons),
+		Description:       d.Description,
+		Extra:             d.SettingsExtra,
+	}
+	v := &deconstructionPlanner{
+		Item:    d.Item,
+		Label:   d.Label,
+		Version: d.Version,
+		Extra:   d.Extra,
+	}
+	if d.EntityFilterMode != 0 || len(s.EntityFilters) > 0 || s.TreesAndRocksOnly ||
+		d.TileFilterMode != 0 || len(s.TileFilters) > 0 || s.TileSelectionMode != 0 ||
+		len(s.Icons) > 0 || s.Description != "" || s.Extra != nil {
+		v.Settings = s
+	}
+	return v
+}
+
+func (u *upgradePlanner) proto() *pb.UpgradePlanner {
+	s := u.Settings
+	if s == nil {
+		s = &upgradeSettings{}
+	}
+	var mappers []*pb.UpgradePlanner_Mapper
+	for _, m := range s.Mappers {
+		mappers = append(mappers, &pb.UpgradePlanner_Mapper{
+			Index: m.Index,
+			From:  m.From.proto(),
+			To:    m.To.proto(),
+		})
+	}
+	return &pb.UpgradePlanner{
+		Version:       u.Version,
+		Item:          u.Item,
+		Label:         u.Label,
+		Icons:         iconsProto(s.Icons),
+		Description:   s.Description,
+		Mappers:       mappers,
+		GameVersion:   Version(u.Version).Proto(),
+		Extra:         u.Extra,
+		SettingsExtra: s.Extra,
+	}
+}
+
+func fromUpgradePlanner(u *pb.UpgradePlanner) *upgradePlanner {
+	s := &upgradeSettings{
+		Icons:       fromIcons(u.Icons),
+		Description: u.Description,
+		Extra:       u.SettingsExtra,
+	}
+	for _, m := range u.Mappers {
+		s.Mappers = append(s.Mappers, mapper{
+			Index: m.Index,
+			From:  fromSignalID(m.From),
+			To:    fromSignalID(m.To),
+		})
+	}
+	v := &upgradePlanner{
+		Item:    u.Item,
+		Label:   u.Label,
+		Version: u.Version,
+		Extra:   u.Extra,
+	}
+	if len(s.Mappers) > 0 || len(s.Icons) > 0 || s.Description != "" || s.Extra != nil {
+		v.Settings = s
+	}
+	return v
+}
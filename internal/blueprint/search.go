@@ -0,0 +1,40 @@
+package blueprint
+
+import (
+	"strings"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+// SearchText returns the text item is found by in searches: its label,
+// description, and the names of its entities and recipes. The entries of
+// books are left out.
+func SearchText(item *pb.Item) string {
+	var (
+		words []string
+		seen  = make(map[string]bool)
+	)
+	add := func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			words = append(words, s)
+		}
+	}
+	_, label, _ := Summary(item)
+	add(label)
+	switch x := item.Item.(type) {
+	case *pb.Item_Blueprint:
+		add(x.Blueprint.Description)
+		for _, e := range x.Blueprint.Entities {
+			add(e.Name)
+			add(e.Recipe)
+		}
+	case *pb.Item_BlueprintBook:
+		add(x.BlueprintBook.Description)
+	case *pb.Item_DeconstructionPlanner:
+		add(x.DeconstructionPlanner.Description)
+	case *pb.Item_UpgradePlanner:
+		add(x.UpgradePlanner.Description)
+	}
+	return strings.Join(words, "\n")
+}
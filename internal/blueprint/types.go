@@ -0,0 +1,166 @@
+package blueprint
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type position struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type color struct {
+	R float64 `json:"r"`
+	G float64 `json:"g"`
+	B float64 `json:"b"`
+	// A is nil when missing, which isn't the same as transparent.
+	A *float64 `json:"a,omitempty"`
+}
+
+type signalID struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+type icon struct {
+	Index  uint32    `json:"index"`
+	Signal *signalID `json:"signal"`
+}
+
+type tile struct {
+	Name     string    `json:"name"`
+	Position *position `json:"position"`
+}
+
+type circuitConnection struct {
+	EntityID  uint32 `json:"entity_id"`
+	CircuitID uint32 `json:"circuit_id,omitempty"`
+}
+
+type copperConnection struct {
+	EntityID uint32 `json:"entity_id"`
+	WireID   uint32 `json:"wire_id"`
+}
+
+type connectionPoint struct {
+	Red   []circuitConnection `json:"red,omitempty"`
+	Green []circuitConnection `json:"green,omitempty"`
+}
+
+type connections struct {
+	Point1 *connectionPoint   `json:"1,omitempty"`
+	Point2 *connectionPoint   `json:"2,omitempty"`
+	Cu0    []copperConnection `json:"Cu0,omitempty"`
+	Cu1    []copperConnection `json:"Cu1,omitempty"`
+}
+
+type entity struct {
+	EntityNumber    uint32            `json:"entity_number"`
+	Name            string            `json:"name"`
+	Position        *position         `json:"position"`
+	Direction       uint32            `json:"direction,omitempty"`
+	Items           map[string]uint32 `json:"items,omitempty"`
+	Recipe          string            `json:"recipe,omitempty"`
+	ControlBehavior json.RawMessage   `json:"control_behavior,omitempty"`
+	Connections     *connections      `json:"connections,omitempty"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+type blueprint struct {
+	Item                   string    `json:"item"`
+	Label                  string    `json:"label,omitempty"`
+	LabelColor             *color    `json:"label_color,omitempty"`
+	Description            string    `json:"description,omitempty"`
+	Icons                  []icon    `json:"icons,omitempty"`
+	Entities               []entity  `json:"entities,omitempty"`
+	Tiles                  []tile    `json:"tiles,omitempty"`
+	SnapToGrid             *position `json:"snap-to-grid,omitempty"`
+	AbsoluteSnapping       bool      `json:"absolute-snapping,omitempty"`
+	PositionRelativeToGrid *position `json:"position-relative-to-grid,omitempty"`
+	Version                uint64    `json:"version"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+type blueprintBook struct {
+	Item        string               `json:"item"`
+	Label       string               `json:"label,omitempty"`
+	LabelColor  *color               `json:"label_color,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Icons       []icon               `json:"icons,omitempty"`
+	ActiveIndex uint64               `json:"active_index"`
+	Blueprints  []blueprintBookEntry `json:"blueprints"`
+	Version     uint64               `json:"version"`
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+type blueprintBookEntry struct {
+	Index uint64 `json:"index"`
+	object
+
+	Extra *structpb.Struct `json:"-"`
+}
+
+func (e *entity) UnmarshalJSON(data []byte) error {
+	type plain entity
+	err := json.Unmarshal(data, (*plain)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra, err = unknownFields(data, (*plain)(e))
+	return err
+}
+
+func (e *entity) MarshalJSON() ([]byte, error) {
+	type plain entity
+	return withUnknownFields((*plain)(e), e.Extra)
+}
+
+func (b *blueprint) UnmarshalJSON(data []byte) error {
+	type plain blueprint
+	err := json.Unmarshal(data, (*plain)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra, err = unknownFields(data, (*plain)(b))
+	return err
+}
+
+func (b *blueprint) MarshalJSON() ([]byte, error) {
+	type plain blueprint
+	return withUnknownFields((*plain)(b), b.Extra)
+}
+
+func (b *blueprintBook) UnmarshalJSON(data []byte) error {
+	type plain blueprintBook
+	err := json.Unmarshal(data, (*plain)(b))
+	if err != nil {
+		return err
+	}
+	b.Extra, err = unknownFields(data, (*plain)(b))
+	return err
+}
+
+func (b *blueprintBook) MarshalJSON() ([]byte, error) {
+	type plain blueprintBook
+	return withUnknownFields((*plain)(b), b.Extra)
+}
+
+func (e *blueprintBookEntry) UnmarshalJSON(data []byte) error {
+	type plain blueprintBookEntry
+	err := json.Unmarshal(data, (*plain)(e))
+	if err != nil {
+		return err
+	}
+	e.Extra, err = unknownFields(data, (*plain)(e))
+	return err
+}
+
+func (e *blueprintBookEntry) MarshalJSON() ([]byte, error) {
+	type plain blueprintBookEntry
+	return withUnknownFields((*plain)(e), e.Extra)
+}
@@ -0,0 +1,307 @@
+package blueprint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ValidationError describes why data is not a valid Factorio object. Path
+// points at the offending JSON value, e.g. "blueprint.entities[3].name".
+type ValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Reason
+	}
+	return e.Path + ": " + e.Reason
+}
+
+// MaxPosition bounds the coordinates of entities and tiles, the size of the
+// Factorio map.
+const MaxPosition = 1000000
+
+func invalid(path, format string, a ...interface{}) error {
+	return &ValidationError{Path: path, Reason: fmt.Sprintf(format, a...)}
+}
+
+// Validate checks that data holds exactly one recognized Factorio object:
+// a blueprint, blueprint_book, deconstruction_planner or upgrade_planner.
+// The returned error is a *ValidationError.
+func Validate(data []byte) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var root interface{}
+	err := d.Decode(&root)
+	if err != nil {
+		return invalid("", "invalid JSON: %v", err)
+	}
+	if d.More() {
+		return invalid("", "unexpected data after JSON object")
+	}
+	m, ok := root.(map[string]interface{})
+	if !ok {
+		return invalid("", "expected a JSON object")
+	}
+	if len(m) != 1 {
+		return invalid("", "expected exactly one object, found %d", len(m))
+	}
+	for k, v := range m {
+		return validateKind(k, k, v)
+	}
+	return nil
+}
+
+// kinds are the recognized kinds of objects.
+var kinds = []string{KindBlueprint, KindBlueprintBook, KindDeconstructionPlanner, KindUpgradePlanner}
+
+func validateKind(path, kind string, v interface{}) error {
+	switch kind {
+	case KindBlueprint:
+		return validateBlueprint(path, v)
+	case KindBlueprintBook:
+		return validateBlueprintBook(path, v)
+	case KindDeconstructionPlanner, KindUpgradePlanner:
+		return validatePlanner(path, v)
+	}
+	return invalid(path, "unrecognized object %q", kind)
+}
+
+func validateBlueprint(path string, v interface{}) error {
+	m, err := asObject(path, v)
+	if err != nil {
+		return err
+	}
+	err = validateCommon(path, m)
+	if err != nil {
+		return err
+	}
+	entities, err := asOptionalArray(path+".entities", m["entities"])
+	if err != nil {
+		return err
+	}
+	for i, e := range entities {
+		p := index(path+".entities", i)
+		e, err := asObject(p, e)
+		if err != nil {
+			return err
+		}
+		_, err = asInteger(p+".entity_number", e["entity_number"])
+		if err != nil {
+			return err
+		}
+		err = asName(p+".name", e["name"])
+		if err != nil {
+			return err
+		}
+		err = validatePosition(p+".position", e["position"])
+		if err != nil {
+			return err
+		}
+	}
+	tiles, err := asOptionalArray(path+".tiles", m["tiles"])
+	if err != nil {
+		return err
+	}
+	for i, t := range tiles {
+		p := index(path+".tiles", i)
+		t, err := asObject(p, t)
+		if err != nil {
+			return err
+		}
+		err = asName(p+".name", t["name"])
+		if err != nil {
+			return err
+		}
+		err = validatePosition(p+".position", t["position"])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateBlueprintBook(path string, v interface{}) error {
+	m, err := asObject(path, v)
+	if err != nil {
+		return err
+	}
+	err = validateCommon(path, m)
+	if err != nil {
+		return err
+	}
+	entries, err := asOptionalArray(path+".blueprints", m["blueprints"])
+	if err != nil {
+		return err
+	}
+	for i, e := range entries {
+		p := index(path+".blueprints", i)
+		e, err := asObject(p, e)
+		if err != nil {
+			return err
+		}
+		_, err = asInteger(p+".index", e["index"])
+		if err != nil {
+			return err
+		}
+		if len(e) == 1 {
+			return invalid(p, "expected an object besides the index")
+		}
+		// Other properties, including objects of unrecognized kinds, are
+		// kept by Decode as they are.
+		var found []string
+		for _, k := range kinds {
+			if _, ok := e[k]; ok {
+				found = append(found, k)
+			}
+		}
+		if len(found) > 1 {
+			return invalid(p, "expected one object, found %s and %s", found[0], found[1])
+		}
+		if len(found) == 1 {
+			err = validateKind(p+"."+found[0], found[0], e[found[0]])
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validatePlanner(path string, v interface{}) error {
+	m, err := asObject(path, v)
+	if err != nil {
+		return err
+	}
+	err = validateCommon(path, m)
+	if err != nil {
+		return err
+	}
+	if s, ok := m["settings"]; ok {
+		_, err = asObject(path+".settings", s)
+	}
+	return err
+}
+
+// validateCommon checks the properties shared by all objects.
+func validateCommon(path string, m map[string]interface{}) error {
+	err := asName(path+".item", m["item"])
+	if err != nil {
+		return err
+	}
+	_, err = asInteger(path+".version", m["version"])
+	if err != nil {
+		return err
+	}
+	if l, ok := m["label"]; ok {
+		if _, ok := l.(string); !ok {
+			return invalid(path+".label", "expected a string")
+		}
+	}
+	icons, err := asOptionalArray(path+".icons", m["icons"])
+	if err != nil {
+		return err
+	}
+	for i, ic := range icons {
+		p := index(path+".icons", i)
+		ic, err := asObject(p, ic)
+		if err != nil {
+			return err
+		}
+		_, err = asInteger(p+".index", ic["index"])
+		if err != nil {
+			return err
+		}
+		s, err := asObject(p+".signal", ic["signal"])
+		if err != nil {
+			return err
+		}
+		switch s["type"] {
+		case "item", "fluid", "virtual":
+		default:
+			return invalid(p+".signal.type", "expected one of item, fluid or virtual")
+		}
+	}
+	return nil
+}
+
+func validatePosition(path string, v interface{}) error {
+	m, err := asObject(path, v)
+	if err != nil {
+		return err
+	}
+	for _, k := range []string{"x", "y"} {
+		n, ok := m[k].(json.Number)
+		if !ok {
+			return invalid(path+"."+k, "expected a number")
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return invalid(path+"."+k, "expected a number")
+		}
+		if math.Abs(f) > MaxPosition {
+			return invalid(path+"."+k, "must be within %d of the origin", MaxPosition)
+		}
+	}
+	return nil
+}
+
+func index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+func asObject(path string, v interface{}) (map[string]interface{}, error) {
+	if v == nil {
+		return nil, invalid(path, "required")
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, invalid(path, "expected an object")
+	}
+	return m, nil
+}
+
+func asOptionalArray(path string, v interface{}) ([]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	a, ok := v.([]interface{})
+	if !ok {
+		return nil, invalid(path, "expected an array")
+	}
+	return a, nil
+}
+
+func asName(path string, v interface{}) error {
+	if v == nil {
+		return invalid(path, "required")
+	}
+	s, ok := v.(string)
+	if !ok {
+		return invalid(path, "expected a string")
+	}
+	if s == "" {
+		return invalid(path, "must not be empty")
+	}
+	return nil
+}
+
+func asInteger(path string, v interface{}) (uint64, error) {
+	if v == nil {
+		return 0, invalid(path, "required")
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, invalid(path, "expected a number")
+	}
+	i, err := strconv.ParseUint(n.String(), 10, 64)
+	if err != nil {
+		return 0, invalid(path, "expected a non-negative integer")
+	}
+	return i, nil
+}
@@ -0,0 +1,126 @@
+package blueprint
+
+import (
+	"errors"
+	"os"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		// path is that of the ValidationError, none if "-".
+		path string
+	}{
+		{"blueprint", "", "-"},
+		{"empty blueprint", `{"blueprint":{"item":"blueprint","version":1}}`, "-"},
+		{"invalid JSON", `{"blueprint":`, ""},
+		{"trailing data", `{"blueprint":{"item":"blueprint","version":1}} {}`, ""},
+		{"not an object", `[]`, ""},
+		{"two objects", `{"blueprint":{"item":"blueprint","version":1},"upgrade_planner":{}}`, ""},
+		{"unrecognized object", `{"blueprint_tag":{}}`, "blueprint_tag"},
+		{"object not an object", `{"blueprint":[]}`, "blueprint"},
+
+		{"missing item", `{"blueprint":{"version":1}}`, "blueprint.item"},
+		{"empty item", `{"blueprint":{"item":"","version":1}}`, "blueprint.item"},
+		{"missing version", `{"blueprint":{"item":"blueprint"}}`, "blueprint.version"},
+		{"negative version", `{"blueprint":{"item":"blueprint","version":-1}}`, "blueprint.version"},
+		{"label not a string", `{"blueprint":{"item":"blueprint","version":1,"label":1}}`, "blueprint.label"},
+
+		{"icons not an array", `{"blueprint":{"item":"blueprint","version":1,"icons":{}}}`, "blueprint.icons"},
+		{"icon without index", `{"blueprint":{"item":"blueprint","version":1,"icons":[
+			{"signal":{"type":"item","name":"iron-plate"}}]}}`, "blueprint.icons[0].index"},
+		{"icon without signal", `{"blueprint":{"item":"blueprint","version":1,"icons":[
+			{"index":1,"signal":{"type":"item","name":"iron-plate"}},{"index":2}]}}`, "blueprint.icons[1].signal"},
+		{"icon of unknown type", `{"blueprint":{"item":"blueprint","version":1,"icons":[
+			{"index":1,"signal":{"type":"tile","name":"concrete"}}]}}`, "blueprint.icons[0].signal.type"},
+
+		{"entities not an array", `{"blueprint":{"item":"blueprint","version":1,"entities":{}}}`, "blueprint.entities"},
+		{"entity not an object", `{"blueprint":{"item":"blueprint","version":1,"entities":[1]}}`, "blueprint.entities[0]"},
+		{"entity without number", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"name":"wooden-chest","position":{"x":0.5,"y":0.5}}]}}`, "blueprint.entities[0].entity_number"},
+		{"entity without name", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":0.5}},
+			{"entity_number":2,"position":{"x":1.5,"y":0.5}}]}}`, "blueprint.entities[1].name"},
+		{"entity without position", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest"}]}}`, "blueprint.entities[0].position"},
+		{"entity position not a number", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":"0.5","y":0.5}}]}}`, "blueprint.entities[0].position.x"},
+		{"entity out of the map", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":1e300}}]}}`, "blueprint.entities[0].position.y"},
+		{"entity at the edge of the map", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":-1000000,"y":1000000}}]}}`, "-"},
+		{"entity past float64", `{"blueprint":{"item":"blueprint","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":1e400,"y":0}}]}}`, "blueprint.entities[0].position.x"},
+
+		{"tile without name", `{"blueprint":{"item":"blueprint","version":1,"tiles":[
+			{"position":{"x":0,"y":0}}]}}`, "blueprint.tiles[0].name"},
+		{"tile without y", `{"blueprint":{"item":"blueprint","version":1,"tiles":[
+			{"name":"concrete","position":{"x":0,"y":0}},{"name":"concrete","position":{"x":1}}]}}`, "blueprint.tiles[1].position.y"},
+		{"tile out of the map", `{"blueprint":{"item":"blueprint","version":1,"tiles":[
+			{"name":"concrete","position":{"x":-1000001,"y":0}}]}}`, "blueprint.tiles[0].position.x"},
+
+		{"book", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","version":1}},
+			{"index":1,"blueprint_book":{"item":"blueprint-book","version":1}}]}}`, "-"},
+		{"book entries not an array", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":{}}}`, "blueprint_book.blueprints"},
+		{"book entry without index", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"blueprint":{"item":"blueprint","version":1}}]}}`, "blueprint_book.blueprints[0].index"},
+		{"book entry without object", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0}]}}`, "blueprint_book.blueprints[0]"},
+		{"book entry with two objects", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","version":1},"upgrade_planner":{"item":"upgrade-planner","version":1}}]}}`, "blueprint_book.blueprints[0]"},
+		{"book entry of unrecognized object", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint_tag":{}}]}}`, "-"},
+		{"book entry with unknown property", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","version":1},"pinned":true}]}}`, "-"},
+		{"invalid object of entry with unknown property", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"pinned":true,"blueprint":{"item":"blueprint"}}]}}`, "blueprint_book.blueprints[0].blueprint.version"},
+		{"invalid nested entry", `{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+				{"index":3,"blueprint":{"item":"blueprint","version":1,"entities":[{"entity_number":1}]}}]}}]}}`,
+			"blueprint_book.blueprints[0].blueprint_book.blueprints[0].blueprint.entities[0].name"},
+
+		{"deconstruction planner", "testdata/deconstruction_planner.json", "-"},
+		{"upgrade planner", "testdata/upgrade_planner.json", "-"},
+		{"planner without item", `{"deconstruction_planner":{"version":1}}`, "deconstruction_planner.item"},
+		{"planner settings not an object", `{"upgrade_planner":{"item":"upgrade-planner","version":1,"settings":[]}}`, "upgrade_planner.settings"},
+		{"planner icon of unknown type", `{"upgrade_planner":{"item":"upgrade-planner","version":1,"icons":[
+			{"index":1,"signal":{"type":"entity","name":"inserter"}}]}}`, "upgrade_planner.icons[0].signal.type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := []byte(tt.data)
+			switch {
+			case tt.data == "":
+				data = readFile(t, "testdata/blueprint.json")
+			case tt.data[0] != '{' && tt.data[0] != '[':
+				data = readFile(t, tt.data)
+			}
+			err := Validate(data)
+			if tt.path == "-" {
+				if err != nil {
+					t.Errorf("Validate: %v", err)
+				}
+				return
+			}
+			var v *ValidationError
+			if !errors.As(err, &v) {
+				t.Fatalf("Validate: got error %v, want a *ValidationError", err)
+			}
+			if v.Path != tt.path {
+				t.Errorf("Validate: got error %v, want path %q", err, tt.path)
+			}
+		})
+	}
+}
+
+func readFile(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,71 @@
+package blueprint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+// Version is the packed game version found in Factorio objects: the major,
+// minor, patch and build numbers as four 16 bit values.
+type Version uint64
+
+func (v Version) Major() uint16 { return uint16(v >> 48) }
+func (v Version) Minor() uint16 { return uint16(v >> 32) }
+func (v Version) Patch() uint16 { return uint16(v >> 16) }
+func (v Version) Build() uint16 { return uint16(v) }
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major(), v.Minor(), v.Patch(), v.Build())
+}
+
+// Proto returns the decoded version, or nil for the zero Version.
+func (v Version) Proto() *pb.GameVersion {
+	if v == 0 {
+		return nil
+	}
+	return &pb.GameVersion{
+		Major: uint32(v.Major()),
+		Minor: uint32(v.Minor()),
+		Patch: uint32(v.Patch()),
+		Build: uint32(v.Build()),
+	}
+}
+
+// ParseVersion parses a dotted version with one to four numbers, such as
+// "1.1" or "1.1.27". It returns the lowest and highest Version matching it.
+func ParseVersion(s string) (lo, hi Version, err error) {
+	parts := strings.Split(s, ".")
+	if len(parts) > 4 {
+		return 0, 0, fmt.Errorf("version %q has more than four numbers", s)
+	}
+	for i := 0; i < 4; i++ {
+		var n uint64 = 0xffff
+		if i < len(parts) {
+			n, err = strconv.ParseUint(parts[i], 10, 16)
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid version %q", s)
+			}
+			lo |= Version(n) << (48 - 16*i)
+		}
+		hi |= Version(n) << (48 - 16*i)
+	}
+	return lo, hi, nil
+}
+
+// ItemVersion returns the version of the top level object of item.
+func ItemVersion(item *pb.Item) Version {
+	switch x := item.Item.(type) {
+	case *pb.Item_Blueprint:
+		return Version(x.Blueprint.Version)
+	case *pb.Item_BlueprintBook:
+		return Version(x.BlueprintBook.Version)
+	case *pb.Item_DeconstructionPlanner:
+		return Version(x.DeconstructionPlanner.Version)
+	case *pb.Item_UpgradePlanner:
+		return Version(x.UpgradePlanner.Version)
+	}
+	return 0
+}
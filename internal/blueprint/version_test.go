@@ -0,0 +1,78 @@
+package blueprint
+
+import (
+	"testing"
+
+	pb "api.fabl.app/pb/fabl/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestVersion(t *testing.T) {
+	tests := []struct {
+		v     Version
+		s     string
+		proto *pb.GameVersion
+	}{
+		{0, "0.0.0.0", nil},
+		{281479271677952, "1.1.0.0", &pb.GameVersion{Major: 1, Minor: 1}},
+		{281479273381889, "1.1.26.1", &pb.GameVersion{Major: 1, Minor: 1, Patch: 26, Build: 1}},
+		{1<<64 - 1, "65535.65535.65535.65535", &pb.GameVersion{Major: 65535, Minor: 65535, Patch: 65535, Build: 65535}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			if s := tt.v.String(); s != tt.s {
+				t.Errorf("String() = %q, want %q", s, tt.s)
+			}
+			if p := tt.v.Proto(); !proto.Equal(p, tt.proto) {
+				t.Errorf("Proto() = %v, want %v", p, tt.proto)
+			}
+			lo, hi, err := ParseVersion(tt.s)
+			if err != nil || lo != tt.v || hi != tt.v {
+				t.Errorf("ParseVersion(%q) = %d, %d, %v, want %d for both", tt.s, lo, hi, err, tt.v)
+			}
+		})
+	}
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		s      string
+		lo, hi string
+	}{
+		{"1", "1.0.0.0", "1.65535.65535.65535"},
+		{"1.1", "1.1.0.0", "1.1.65535.65535"},
+		{"1.1.27", "1.1.27.0", "1.1.27.65535"},
+		{"0.18.0", "0.18.0.0", "0.18.0.65535"},
+		{"1.1.27.4", "1.1.27.4", "1.1.27.4"},
+		{"65535", "65535.0.0.0", "65535.65535.65535.65535"},
+
+		// Malformed versions have no lo or hi.
+		{"", "", ""},
+		{"1.", "", ""},
+		{"1..1", "", ""},
+		{"v1.1", "", ""},
+		{"1.1 ", "", ""},
+		{"-1", "", ""},
+		{"+1", "", ""},
+		{"1.1.27.4.0", "", ""},
+		{"1.65536", "", ""},
+		{"1.1.1.99999999999999999999", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			lo, hi, err := ParseVersion(tt.s)
+			if tt.lo == "" {
+				if err == nil {
+					t.Errorf("ParseVersion(%q) = %v, %v, want an error", tt.s, lo, hi)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseVersion(%q): %v", tt.s, err)
+			}
+			if lo.String() != tt.lo || hi.String() != tt.hi {
+				t.Errorf("ParseVersion(%q) = %v, %v, want %s, %s", tt.s, lo, hi, tt.lo, tt.hi)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package blueprint
+
+import (
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+// Walk calls fn for item and, depth first, for every entry of books. path
+// holds the entry indices leading from item to the visited item, and must not
+// be retained by fn.
+func Walk(item *pb.Item, fn func(path []uint64, item *pb.Item)) {
+	walk(nil, item, fn)
+}
+
+func walk(path []uint64, item *pb.Item, fn func([]uint64, *pb.Item)) {
+	fn(path, item)
+	book := item.GetBlueprintBook()
+	if book == nil {
+		return
+	}
+	for _, e := range book.Blueprints {
+		walk(append(path, e.Index), EntryItem(e), fn)
+	}
+}
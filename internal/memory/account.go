@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"context"
+	"strings"
+
+	"api.fabl.app/internal/repository"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type accountRepo struct {
+	s *store
+}
+
+func (r *accountRepo) Get(ctx context.Context, id uuid.UUID) (*repository.Account, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	acc, ok := r.s.accounts[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+	v := *acc
+	return &v, nil
+}
+
+func (r *accountRepo) GetByNickname(ctx context.Context, nickname string) (*repository.Account, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, acc := range r.s.accounts {
+		if strings.ToLower(acc.Nickname) == strings.ToLower(nickname) {
+			v := *acc
+			return &v, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "account not found")
+}
+
+func (r *accountRepo) Create(ctx context.Context, acc *repository.Account) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for id, other := range r.s.accounts {
+		if id == acc.ID || strings.ToLower(other.Nickname) == strings.ToLower(acc.Nickname) {
+			return status.Error(codes.AlreadyExists, "account already exists")
+		}
+	}
+	v := *acc
+	r.s.accounts[acc.ID] = &v
+	return nil
+}
+
+func (r *accountRepo) FromToken(ctx context.Context, token string) (*repository.Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "memory.accountRepo method FromToken not implemented")
+}
@@ -0,0 +1,558 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"api.fabl.app/internal/repository"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// blob is the item data with a given sum, shared by the items and revisions
+// referencing it.
+type blob struct {
+	data    []byte
+	kind    string
+	version uint64
+	search  *repository.SearchIndex
+}
+
+type storedItem struct {
+	accountID   uuid.UUID
+	title       string
+	description string
+	// tags are sorted.
+	tags []string
+	// revisions are oldest first, the last one is current.
+	revisions []revision
+	deletedAt time.Time
+}
+
+type revision struct {
+	sum      [32]byte
+	original []byte
+	timeMs   uint64
+}
+
+func (i *storedItem) current() *revision {
+	return &i.revisions[len(i.revisions)-1]
+}
+
+func (i *storedItem) metadata() repository.Metadata {
+	return repository.Metadata{
+		Title:       i.title,
+		Description: i.description,
+		Tags:        append([]string(nil), i.tags...),
+	}
+}
+
+type itemRepo struct {
+	s *store
+}
+
+// item returns an item of the account that is not in the trash. s.mu must be
+// held.
+func (r *itemRepo) item(accountID uuid.UUID, id ulid.ULID) (*storedItem, error) {
+	i, ok := r.s.items[id]
+	if !ok || i.accountID != accountID || !i.deletedAt.IsZero() {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	return i, nil
+}
+
+// listItem returns an item without its Data or Original.
+func (r *itemRepo) listItem(id ulid.ULID, i *storedItem) *repository.Item {
+	cur := i.current()
+	d := r.s.data[cur.sum]
+	sum := cur.sum
+	return &repository.Item{
+		ULID:     id,
+		TimeMs:   id.Time(),
+		Sum256:   &sum,
+		Kind:     d.kind,
+		Version:  d.version,
+		Metadata: i.metadata(),
+	}
+}
+
+func (r *itemRepo) Get(ctx context.Context, accountID uuid.UUID, id ulid.ULID) (*repository.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	cur := i.current()
+	item := r.listItem(id, i)
+	item.Data = r.s.data[cur.sum].data
+	item.Original = cur.original
+	item.Revision = uint64(len(i.revisions))
+	return item, nil
+}
+
+func (r *itemRepo) Create(ctx context.Context, accountID uuid.UUID, item *repository.Item) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if item.TimeMs == 0 {
+		item.TimeMs = ulid.Now()
+	}
+	sum := sha256.Sum256(item.Data)
+	id, err := ulid.New(item.TimeMs, bytes.NewReader(sum[:]))
+	if err != nil {
+		return err
+	}
+	if _, ok := r.s.items[id]; ok {
+		return status.Error(codes.AlreadyExists, "item already exists")
+	}
+	r.insertData(item)
+	item.ULID = id
+	item.Revision = 1
+	r.s.items[id] = &storedItem{
+		accountID: accountID,
+		revisions: []revision{{
+			sum:      sum,
+			original: item.Original,
+			timeMs:   item.TimeMs,
+		}},
+	}
+	return nil
+}
+
+// insertData stores item.Data unless already present and sets item.Sum256.
+// s.mu must be held.
+func (r *itemRepo) insertData(item *repository.Item) {
+	sum := sha256.Sum256(item.Data)
+	item.Sum256 = &sum
+	d, ok := r.s.data[sum]
+	if !ok {
+		d = &blob{
+			data:    item.Data,
+			kind:    item.Kind,
+			version: item.Version,
+		}
+		r.s.data[sum] = d
+	}
+	if d.search == nil {
+		d.search = item.Search
+	}
+}
+
+func (r *itemRepo) List(ctx context.Context, accountID uuid.UUID, opts repository.ListOptions) ([]*repository.Item, int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var items []*repository.Item
+	for id, i := range r.s.items {
+		if i.accountID != accountID || !i.deletedAt.IsZero() {
+			continue
+		}
+		d := r.s.data[i.current().sum]
+		switch {
+		case opts.MinVersion != 0 && d.version < opts.MinVersion,
+			opts.MaxVersion != 0 && d.version > opts.MaxVersion,
+			opts.FromMs != 0 && id.Time() < opts.FromMs,
+			opts.ToMs != 0 && id.Time() >= opts.ToMs,
+			!hasTags(i.tags, opts.Tags),
+			!matchContent(d, opts.Content):
+			continue
+		}
+		items = append(items, r.listItem(id, i))
+	}
+	sort.Slice(items, func(a, b int) bool {
+		if opts.Descending {
+			a, b = b, a
+		}
+		return items[a].ULID.Compare(items[b].ULID) < 0
+	})
+	total := len(items)
+	if opts.After != (ulid.ULID{}) {
+		n := sort.Search(len(items), func(a int) bool {
+			c := items[a].ULID.Compare(opts.After)
+			if opts.Descending {
+				return c < 0
+			}
+			return c > 0
+		})
+		items = items[n:]
+	}
+	if opts.Limit > 0 && len(items) > opts.Limit {
+		items = items[:opts.Limit]
+	}
+	return items, total, nil
+}
+
+// hasTags tells if the sorted tags contain all of want.
+func hasTags(tags, want []string) bool {
+	for _, tag := range want {
+		n := sort.SearchStrings(tags, tag)
+		if n == len(tags) || tags[n] != tag {
+			return false
+		}
+	}
+	return true
+}
+
+// matchContent tells if the item data matches f. Data stored without a
+// search index contains no entities or recipes.
+func matchContent(d *blob, f repository.ContentFilter) bool {
+	index := d.search
+	if index == nil {
+		index = &repository.SearchIndex{}
+	}
+	for _, name := range f.Entities {
+		if !contains(index.Entities, name) {
+			return false
+		}
+	}
+	for _, name := range f.Recipes {
+		if !contains(index.Recipes, name) {
+			return false
+		}
+	}
+	for _, name := range f.ExcludedEntities {
+		if contains(index.Entities, name) {
+			return false
+		}
+	}
+	return !f.NoModded || d.search != nil && !d.search.Modded
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *itemRepo) UpdateMetadata(ctx context.Context, accountID uuid.UUID, id ulid.ULID, md *repository.Metadata, fields []string) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return err
+	}
+	v := *i
+	for _, field := range fields {
+		switch field {
+		case "title":
+			v.title = md.Title
+		case "description":
+			v.description = md.Description
+		case "tags":
+			v.tags = nil
+			for _, tag := range md.Tags {
+				if !contains(v.tags, tag) {
+					v.tags = append(v.tags, tag)
+				}
+			}
+			sort.Strings(v.tags)
+		default:
+			return fmt.Errorf("unknown metadata field %q", field)
+		}
+	}
+	*i = v
+	*md = i.metadata()
+	return nil
+}
+
+func (r *itemRepo) GetData(ctx context.Context, accountID uuid.UUID, sum256 [32]byte) ([]byte, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, i := range r.s.items {
+		if i.accountID != accountID || !i.deletedAt.IsZero() {
+			continue
+		}
+		for _, rev := range i.revisions {
+			if rev.sum == sum256 {
+				return r.s.data[sum256].data, nil
+			}
+		}
+	}
+	return nil, status.Error(codes.NotFound, "data not found")
+}
+
+func (r *itemRepo) AddRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, item *repository.Item) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return err
+	}
+	r.insertData(item)
+	item.ULID = id
+	if *item.Sum256 == i.current().sum {
+		item.Revision = uint64(len(i.revisions))
+		return nil
+	}
+	if item.TimeMs == 0 {
+		item.TimeMs = ulid.Now()
+	}
+	i.revisions = append(i.revisions, revision{
+		sum:      *item.Sum256,
+		original: item.Original,
+		timeMs:   item.TimeMs,
+	})
+	item.Revision = uint64(len(i.revisions))
+	return nil
+}
+
+func (r *itemRepo) ListRevisions(ctx context.Context, accountID uuid.UUID, id ulid.ULID) ([]*repository.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*repository.Item, len(i.revisions))
+	for n, rev := range i.revisions {
+		d := r.s.data[rev.sum]
+		sum := rev.sum
+		items[n] = &repository.Item{
+			ULID:     id,
+			TimeMs:   rev.timeMs,
+			Sum256:   &sum,
+			Kind:     d.kind,
+			Version:  d.version,
+			Revision: uint64(n + 1),
+		}
+	}
+	return items, nil
+}
+
+func (r *itemRepo) GetRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, revision uint64) (*repository.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+	if revision < 1 || revision > uint64(len(i.revisions)) {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+	rev := i.revisions[revision-1]
+	d := r.s.data[rev.sum]
+	return &repository.Item{
+		ULID:     id,
+		TimeMs:   rev.timeMs,
+		Data:     d.data,
+		Sum256:   &rev.sum,
+		Kind:     d.kind,
+		Version:  d.version,
+		Original: rev.original,
+		Revision: revision,
+	}, nil
+}
+
+func (r *itemRepo) RevertToRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, revision uint64) (uint64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return 0, err
+	}
+	if revision < 1 || revision > uint64(len(i.revisions)) {
+		return 0, status.Error(codes.NotFound, "revision not found")
+	}
+	rev := i.revisions[revision-1]
+	rev.timeMs = ulid.Now()
+	i.revisions = append(i.revisions, rev)
+	return uint64(len(i.revisions)), nil
+}
+
+func (r *itemRepo) Search(ctx context.Context, accountID uuid.UUID, query string, opts repository.SearchOptions) ([]*repository.SearchResult, int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	include, exclude := repository.SearchWords(query)
+	var results []*repository.SearchResult
+	for id, i := range r.s.items {
+		if i.accountID != accountID || !i.deletedAt.IsZero() {
+			continue
+		}
+		d := r.s.data[i.current().sum]
+		index := d.search
+		if index == nil {
+			index = &repository.SearchIndex{}
+		}
+		// Weighed like the search document of the sql package.
+		var (
+			heading     = strings.ToLower(i.title + " " + strings.Join(i.tags, " "))
+			description = strings.ToLower(i.description)
+			text        = strings.ToLower(index.Text)
+		)
+		if !repository.MatchWords(heading+" "+description+" "+text, include, exclude) ||
+			!matchContent(d, opts.Content) {
+			continue
+		}
+		var rank float32
+		for _, word := range include {
+			if strings.Contains(heading, word) {
+				rank += 1.0
+			}
+			if strings.Contains(description, word) {
+				rank += 0.4
+			}
+			if strings.Contains(text, word) {
+				rank += 0.1
+			}
+		}
+		var pages []repository.PageMatch
+		for _, p := range index.Pages {
+			if repository.MatchWords(p.Label+" "+p.Text, include, exclude) {
+				pages = append(pages, repository.PageMatch{
+					Path:     p.Path,
+					Label:    p.Label,
+					Headline: repository.Highlight(p.Label, include),
+				})
+			}
+		}
+		results = append(results, &repository.SearchResult{
+			Item:  r.listItem(id, i),
+			Rank:  rank,
+			Pages: pages,
+		})
+	}
+	sort.Slice(results, func(a, b int) bool {
+		if results[a].Rank != results[b].Rank {
+			return results[a].Rank > results[b].Rank
+		}
+		return results[a].Item.ULID.Compare(results[b].Item.ULID) > 0
+	})
+	total := len(results)
+	if opts.Offset >= len(results) {
+		return nil, total, nil
+	}
+	results = results[opts.Offset:]
+	if opts.Limit > 0 && len(results) > opts.Limit {
+		results = results[:opts.Limit]
+	}
+	return results, total, nil
+}
+
+func (r *itemRepo) Delete(ctx context.Context, accountID uuid.UUID, id ulid.ULID) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, err := r.item(accountID, id)
+	if err != nil {
+		return err
+	}
+	i.deletedAt = time.Now()
+	return nil
+}
+
+func (r *itemRepo) Restore(ctx context.Context, accountID uuid.UUID, id ulid.ULID) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i, ok := r.s.items[id]
+	if !ok || i.accountID != accountID || i.deletedAt.IsZero() {
+		return status.Error(codes.NotFound, "item not found")
+	}
+	i.deletedAt = time.Time{}
+	return nil
+}
+
+func (r *itemRepo) ListTrash(ctx context.Context, accountID uuid.UUID) ([]*repository.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var items []*repository.Item
+	for id, i := range r.s.items {
+		if i.accountID != accountID || i.deletedAt.IsZero() {
+			continue
+		}
+		item := r.listItem(id, i)
+		item.Version = 0
+		item.Metadata = repository.Metadata{}
+		item.DeletedMs = uint64(i.deletedAt.UnixNano() / int64(time.Millisecond))
+		items = append(items, item)
+	}
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].DeletedMs > items[b].DeletedMs
+	})
+	return items, nil
+}
+
+func (r *itemRepo) PurgeTrash(ctx context.Context, accountID uuid.UUID) (int, error) {
+	return r.purge(func(i *storedItem) bool {
+		return i.accountID == accountID && !i.deletedAt.IsZero()
+	})
+}
+
+func (r *itemRepo) PurgeExpiredTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	return r.purge(func(i *storedItem) bool {
+		return !i.deletedAt.IsZero() && i.deletedAt.Before(deletedBefore)
+	})
+}
+
+// purge removes the items matching f with their revisions, and the item data
+// they referenced that is no longer referenced.
+func (r *itemRepo) purge(f func(*storedItem) bool) (int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var (
+		n    int
+		sums = make(map[[32]byte]bool)
+	)
+	for id, i := range r.s.items {
+		if !f(i) {
+			continue
+		}
+		for _, rev := range i.revisions {
+			sums[rev.sum] = true
+		}
+		delete(r.s.items, id)
+		n++
+	}
+	r.deleteUnreferenced(sums)
+	return n, nil
+}
+
+// unreferenced removes the sums referenced by an item from sums. s.mu must be
+// held.
+func (r *itemRepo) unreferenced(sums map[[32]byte]bool) {
+	for _, i := range r.s.items {
+		for _, rev := range i.revisions {
+			delete(sums, rev.sum)
+		}
+	}
+}
+
+// deleteUnreferenced deletes the item data with the given sums that no item
+// references, and returns the number of bytes deleted. s.mu must be held.
+func (r *itemRepo) deleteUnreferenced(sums map[[32]byte]bool) int64 {
+	r.unreferenced(sums)
+	var size int64
+	for sum := range sums {
+		if d, ok := r.s.data[sum]; ok {
+			size += int64(len(d.data))
+			delete(r.s.data, sum)
+		}
+	}
+	return size
+}
+
+func (r *itemRepo) CollectGarbage(ctx context.Context, dryRun bool) (int, int64, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	sums := make(map[[32]byte]bool)
+	for sum := range r.s.data {
+		sums[sum] = true
+	}
+	if !dryRun {
+		size := r.deleteUnreferenced(sums)
+		return len(sums), size, nil
+	}
+	r.unreferenced(sums)
+	var size int64
+	for sum := range sums {
+		size += int64(len(r.s.data[sum].data))
+	}
+	return len(sums), size, nil
+}
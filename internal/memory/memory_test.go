@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"testing"
+
+	"api.fabl.app/internal/repository/repositorytest"
+)
+
+func TestRepository(t *testing.T) {
+	repo := NewRepository()
+	repositorytest.Run(t, &repositorytest.Backend{
+		Account: repo.Account,
+		Item:    repo.Item,
+	})
+}
@@ -0,0 +1,38 @@
+// Package memory keeps accounts and items in memory, for tests and demos.
+package memory
+
+import (
+	"sync"
+
+	"api.fabl.app/internal/repository"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+type Repository struct {
+	Account repository.AccountRepository
+	Item    repository.ItemRepository
+
+	s *store
+}
+
+func NewRepository() *Repository {
+	s := &store{
+		accounts: make(map[uuid.UUID]*repository.Account),
+		items:    make(map[ulid.ULID]*storedItem),
+		data:     make(map[[32]byte]*blob),
+	}
+	return &Repository{
+		Account: &accountRepo{s},
+		Item:    &itemRepo{s},
+		s:       s,
+	}
+}
+
+// store holds the state shared by the repositories, guarded by mu.
+type store struct {
+	mu       sync.Mutex
+	accounts map[uuid.UUID]*repository.Account
+	items    map[ulid.ULID]*storedItem
+	data     map[[32]byte]*blob
+}
@@ -0,0 +1,148 @@
+package preview
+
+import (
+	"container/list"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"api.fabl.app/internal/blueprint"
+	"api.fabl.app/internal/repository"
+	"api.fabl.app/internal/session"
+	pb "api.fabl.app/pb/fabl/v1"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/status"
+)
+
+type cacheKey struct {
+	sum256 [32]byte
+	format string
+}
+
+type cacheEntry struct {
+	key  cacheKey
+	data []byte
+}
+
+// cache is a least recently used cache of rendered previews, holding up to
+// max bytes of them.
+type cache struct {
+	mu   sync.Mutex
+	max  int64
+	used int64
+	ll   *list.List
+	m    map[cacheKey]*list.Element
+}
+
+func (c *cache) get(k cacheKey) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.m[k]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(e)
+	return e.Value.(*cacheEntry).data, true
+}
+
+func (c *cache) add(k cacheKey, data []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.m[k]; ok || int64(len(data)) > c.max {
+		return
+	}
+	c.m[k] = c.ll.PushFront(&cacheEntry{key: k, data: data})
+	c.used += int64(len(data))
+	for c.used > c.max {
+		e := c.ll.Back()
+		c.ll.Remove(e)
+		entry := e.Value.(*cacheEntry)
+		delete(c.m, entry.key)
+		c.used -= int64(len(entry.data))
+	}
+}
+
+// Handler serves previews of items. Previews are cached by the sum256 of the
+// item data, so identical content is rendered once, and tagged with it and
+// rendererVersion for clients.
+type Handler struct {
+	repo  repository.ItemRepository
+	cache *cache
+}
+
+// NewHandler initializes a Handler caching up to cacheBytes of previews.
+func NewHandler(repo repository.ItemRepository, cacheBytes int64) *Handler {
+	return &Handler{
+		repo: repo,
+		cache: &cache{
+			max: cacheBytes,
+			ll:  list.New(),
+			m:   make(map[cacheKey]*list.Element),
+		},
+	}
+}
+
+// SVG serves the SVG preview of the item with the "id" path parameter.
+func (h *Handler) SVG(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	h.serve(w, r, params["id"], "image/svg+xml", SVG)
+}
+
+// PNG serves the PNG preview of the item with the "id" path parameter.
+func (h *Handler) PNG(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	h.serve(w, r, params["id"], "image/png", PNG)
+}
+
+func (h *Handler) serve(w http.ResponseWriter, r *http.Request, id, contentType string, render func(*pb.Item) ([]byte, error)) {
+	ctx := r.Context()
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	itemID, err := ulid.Parse(id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	item, err := h.repo.Get(ctx, accountID, itemID)
+	if err != nil {
+		httpError(w, err)
+		return
+	}
+	key := cacheKey{*item.Sum256, contentType}
+	etag := `"` + hex.EncodeToString(key.sum256[:]) + "-" + strconv.Itoa(rendererVersion) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "private, max-age=86400")
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	data, ok := h.cache.get(key)
+	if !ok {
+		decoded, err := blueprint.Decode(item.Data)
+		if err == nil {
+			data, err = render(decoded)
+		}
+		if errors.Is(err, blueprint.ErrUnrecognized) || errors.Is(err, ErrNothingToRender) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		} else if errors.Is(err, ErrOutOfRange) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		} else if err != nil {
+			httpError(w, err)
+			return
+		}
+		h.cache.add(key, data)
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(data)
+}
+
+func httpError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	http.Error(w, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+}
@@ -0,0 +1,36 @@
+package preview
+
+import (
+	"container/list"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	c := &cache{max: 10, ll: list.New(), m: make(map[cacheKey]*list.Element)}
+	key := func(b byte, format string) cacheKey {
+		return cacheKey{sum256: [32]byte{b}, format: format}
+	}
+	c.add(key(1, "png"), make([]byte, 4))
+	c.add(key(2, "png"), make([]byte, 4))
+	c.add(key(1, "svg"), make([]byte, 11))
+	if _, ok := c.get(key(1, "png")); !ok {
+		t.Error("first preview missing")
+	}
+	if _, ok := c.get(key(1, "svg")); ok {
+		t.Error("preview larger than the cache was cached")
+	}
+
+	// The second preview is the least recently used.
+	c.add(key(3, "png"), make([]byte, 4))
+	if _, ok := c.get(key(2, "png")); ok {
+		t.Error("least recently used preview kept past the size of the cache")
+	}
+	for _, b := range []byte{1, 3} {
+		if _, ok := c.get(key(b, "png")); !ok {
+			t.Errorf("preview %d missing", b)
+		}
+	}
+	if c.used != 8 {
+		t.Errorf("used = %d, want 8", c.used)
+	}
+}
@@ -0,0 +1,81 @@
+package preview
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+type rasterCanvas struct {
+	img        *image.RGBA
+	minX, minY float64
+	scale      float64
+}
+
+func (r rasterCanvas) px(x, y float64) (float64, float64) {
+	return (x - r.minX) * r.scale, (y - r.minY) * r.scale
+}
+
+func (r rasterCanvas) rect(x, y, w, h float64, c color.RGBA) {
+	x0, y0 := r.px(x, y)
+	x1, y1 := r.px(x+w, y+h)
+	rect := image.Rect(int(math.Round(x0)), int(math.Round(y0)), int(math.Round(x1)), int(math.Round(y1)))
+	rect = rect.Intersect(r.img.Bounds())
+	for py := rect.Min.Y; py < rect.Max.Y; py++ {
+		for px := rect.Min.X; px < rect.Max.X; px++ {
+			r.img.SetRGBA(px, py, c)
+		}
+	}
+}
+
+func (r rasterCanvas) line(x1, y1, x2, y2, width float64, c color.RGBA) {
+	ax, ay := r.px(x1, y1)
+	bx, by := r.px(x2, y2)
+	half := math.Max(width*r.scale/2, 0.5)
+	rect := image.Rect(
+		int(math.Floor(math.Min(ax, bx)-half)), int(math.Floor(math.Min(ay, by)-half)),
+		int(math.Ceil(math.Max(ax, bx)+half)), int(math.Ceil(math.Max(ay, by)+half)),
+	).Intersect(r.img.Bounds())
+	dx, dy := bx-ax, by-ay
+	l2 := dx*dx + dy*dy
+	for py := rect.Min.Y; py < rect.Max.Y; py++ {
+		for px := rect.Min.X; px < rect.Max.X; px++ {
+			// Distance from the pixel center to the segment.
+			cx, cy := float64(px)+0.5, float64(py)+0.5
+			t := 0.0
+			if l2 > 0 {
+				t = math.Max(0, math.Min(1, ((cx-ax)*dx+(cy-ay)*dy)/l2))
+			}
+			if math.Hypot(cx-ax-t*dx, cy-ay-t*dy) <= half {
+				r.img.SetRGBA(px, py, c)
+			}
+		}
+	}
+}
+
+// PNG renders the blueprint in item, or the active blueprint of a book.
+func PNG(item *pb.Item) ([]byte, error) {
+	b := target(item)
+	if b == nil || len(b.Entities)+len(b.Tiles) == 0 {
+		return nil, ErrNothingToRender
+	}
+	bb, err := measure(b)
+	if err != nil {
+		return nil, err
+	}
+	s := scale(bb)
+	w := int(math.Ceil((bb.maxX - bb.minX) * s))
+	h := int(math.Ceil((bb.maxY - bb.minY) * s))
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw(rasterCanvas{img: img, minX: bb.minX, minY: bb.minY, scale: s}, b)
+	buf := new(bytes.Buffer)
+	err = png.Encode(buf, img)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,185 @@
+// Package preview renders thumbnails of blueprints as SVG or PNG images.
+package preview
+
+import (
+	"errors"
+	"image/color"
+	"math"
+
+	"api.fabl.app/internal/blueprint"
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+var (
+	// ErrNothingToRender is returned for items without a blueprint to draw.
+	ErrNothingToRender = errors.New("preview: nothing to render")
+	// ErrOutOfRange is returned for blueprints reaching past the map.
+	ErrOutOfRange = errors.New("preview: blueprint out of range")
+)
+
+// rendererVersion is part of the ETag of previews. Increase it when changing
+// how previews look, so that clients don't keep the previous ones.
+const rendererVersion = 1
+
+// maxCoordinate bounds the rendered tile coordinates, past
+// blueprint.MaxPosition to leave room for the footprints of entities.
+const maxCoordinate = blueprint.MaxPosition + 64
+
+// canvas is drawn on in tile coordinates.
+type canvas interface {
+	rect(x, y, w, h float64, c color.RGBA)
+	line(x1, y1, x2, y2, width float64, c color.RGBA)
+}
+
+var (
+	colorTile    = color.RGBA{0x4a, 0x4a, 0x4a, 0xff}
+	colorEntity  = color.RGBA{0x8c, 0x8c, 0x8c, 0xff}
+	colorUnknown = color.RGBA{0xc0, 0x40, 0xc0, 0xff}
+	colorArrow   = color.RGBA{0x20, 0x20, 0x20, 0xff}
+	colorRail    = color.RGBA{0xb0, 0x9a, 0x78, 0xff}
+
+	typeColors = map[string]color.RGBA{
+		"transport-belt":     {0xd8, 0xb4, 0x3c, 0xff},
+		"underground-belt":   {0xd8, 0xb4, 0x3c, 0xff},
+		"splitter":           {0xd8, 0xb4, 0x3c, 0xff},
+		"loader":             {0xd8, 0xb4, 0x3c, 0xff},
+		"inserter":           {0x5a, 0x8c, 0xc8, 0xff},
+		"electric-pole":      {0x8c, 0x5a, 0x3c, 0xff},
+		"pipe":               {0x50, 0x78, 0xa0, 0xff},
+		"pipe-to-ground":     {0x50, 0x78, 0xa0, 0xff},
+		"assembling-machine": {0x6e, 0x82, 0x6e, 0xff},
+		"furnace":            {0xa0, 0x64, 0x3c, 0xff},
+		"mining-drill":       {0x64, 0x6e, 0x8c, 0xff},
+		"beacon":             {0x6e, 0x5a, 0x96, 0xff},
+		"wall":               {0xa0, 0xa0, 0x8c, 0xff},
+		"container":          {0xa0, 0x82, 0x50, 0xff},
+		"logistic-container": {0xa0, 0x82, 0x50, 0xff},
+	}
+
+	// directional types get an arrow to show their rotation.
+	directional = map[string]bool{
+		"transport-belt":        true,
+		"underground-belt":      true,
+		"splitter":              true,
+		"loader":                true,
+		"inserter":              true,
+		"pump":                  true,
+		"offshore-pump":         true,
+		"boiler":                true,
+		"generator":             true,
+		"mining-drill":          true,
+		"arithmetic-combinator": true,
+		"decider-combinator":    true,
+		"train-stop":            true,
+		"rail-signal":           true,
+		"rail-chain-signal":     true,
+		"fluid-turret":          true,
+	}
+)
+
+// bounds in tile coordinates.
+type bounds struct {
+	minX, minY, maxX, maxY float64
+}
+
+func (b *bounds) add(x, y, w, h float64) {
+	b.minX = math.Min(b.minX, x)
+	b.minY = math.Min(b.minY, y)
+	b.maxX = math.Max(b.maxX, x+w)
+	b.maxY = math.Max(b.maxY, y+h)
+}
+
+// target returns the blueprint to render for item: the blueprint itself, or
+// the active (or else first) blueprint of a book.
+func target(item *pb.Item) *pb.Blueprint {
+	switch x := item.Item.(type) {
+	case *pb.Item_Blueprint:
+		return x.Blueprint
+	case *pb.Item_BlueprintBook:
+		var first *pb.Blueprint
+		for _, e := range x.BlueprintBook.Blueprints {
+			b := target(blueprint.EntryItem(e))
+			if b == nil {
+				continue
+			}
+			if e.Index == x.BlueprintBook.ActiveIndex {
+				return b
+			}
+			if first == nil {
+				first = b
+			}
+		}
+		return first
+	}
+	return nil
+}
+
+// direction returns the unit vector Factorio direction d points to.
+func direction(d uint32) (float64, float64) {
+	a := float64(d%8) * math.Pi / 4
+	return math.Sin(a), -math.Cos(a)
+}
+
+// footprint returns the size of entity e in tiles.
+func footprint(e *pb.Entity) (w, h float64, p blueprint.Prototype, known bool) {
+	p, known = blueprint.Prototypes[e.Name]
+	if !known {
+		return 1, 1, p, false
+	}
+	w, h = float64(p.Width), float64(p.Height)
+	if e.Direction%4 == 2 && p.Type != "curved-rail" {
+		w, h = h, w
+	}
+	return w, h, p, true
+}
+
+// measure returns the bounds of b, or ErrOutOfRange if they are not within
+// maxCoordinate.
+func measure(b *pb.Blueprint) (bounds, error) {
+	bb := bounds{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, t := range b.Tiles {
+		bb.add(t.Position.GetX(), t.Position.GetY(), 1, 1)
+	}
+	for _, e := range b.Entities {
+		w, h, _, _ := footprint(e)
+		bb.add(e.Position.GetX()-w/2, e.Position.GetY()-h/2, w, h)
+	}
+	// The comparisons are false for NaN.
+	for _, v := range []float64{bb.minX, bb.minY, bb.maxX, bb.maxY} {
+		if !(math.Abs(v) <= maxCoordinate) {
+			return bb, ErrOutOfRange
+		}
+	}
+	return bb, nil
+}
+
+func draw(c canvas, b *pb.Blueprint) {
+	for _, t := range b.Tiles {
+		c.rect(t.Position.GetX(), t.Position.GetY(), 1, 1, colorTile)
+	}
+	for _, e := range b.Entities {
+		x, y := e.Position.GetX(), e.Position.GetY()
+		w, h, p, known := footprint(e)
+		dx, dy := direction(e.Direction)
+		switch {
+		case !known:
+			c.rect(x-w/2, y-h/2, w, h, colorUnknown)
+		case p.Type == "straight-rail":
+			c.line(x-dx, y-dy, x+dx, y+dy, 0.6, colorRail)
+		case p.Type == "curved-rail":
+			c.line(x-dx*3, y-dy*3, x+dx*3, y+dy*3, 0.6, colorRail)
+		default:
+			col, ok := typeColors[p.Type]
+			if !ok {
+				col = colorEntity
+			}
+			// Leave a small gap, so neighbouring entities can be told apart.
+			c.rect(x-w/2+0.05, y-h/2+0.05, w-0.1, h-0.1, col)
+			if directional[p.Type] {
+				l := math.Min(w, h) / 2 * 0.8
+				c.line(x-dx*l, y-dy*l, x+dx*l, y+dy*l, 0.15, colorArrow)
+				c.rect(x+dx*l-0.1, y+dy*l-0.1, 0.2, 0.2, colorArrow)
+			}
+		}
+	}
+}
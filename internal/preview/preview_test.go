@@ -0,0 +1,92 @@
+package preview
+
+import (
+	"bytes"
+	"image/png"
+	"math"
+	"regexp"
+	"strconv"
+	"testing"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+func chests(positions ...float64) *pb.Item {
+	b := &pb.Blueprint{}
+	for i := 0; i < len(positions); i += 2 {
+		b.Entities = append(b.Entities, &pb.Entity{
+			EntityNumber: uint32(i/2 + 1),
+			Name:         "wooden-chest",
+			Position:     &pb.Position{X: positions[i], Y: positions[i+1]},
+		})
+	}
+	return &pb.Item{Item: &pb.Item_Blueprint{Blueprint: b}}
+}
+
+var svgSize = regexp.MustCompile(`width="(\d+)" height="(\d+)"`)
+
+func TestSize(t *testing.T) {
+	tests := []struct {
+		name string
+		item *pb.Item
+		w, h int
+	}{
+		{"one chest", chests(0.5, 0.5), pixelsPerTile, pixelsPerTile},
+		{"row", chests(0.5, 0.5, 9.5, 0.5), 10 * pixelsPerTile, pixelsPerTile},
+		{"far apart", chests(0.5, 0.5, 20000.5, 20000.5), maxPixels, maxPixels},
+		{"wide", chests(-50000.5, 0.5, 50000.5, 0.5), maxPixels, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := PNG(tt.item)
+			if err != nil {
+				t.Fatalf("PNG: %v", err)
+			}
+			img, err := png.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size := img.Bounds().Size(); size.X != tt.w || size.Y != tt.h {
+				t.Errorf("PNG is %dx%d, want %dx%d", size.X, size.Y, tt.w, tt.h)
+			}
+
+			data, err = SVG(tt.item)
+			if err != nil {
+				t.Fatalf("SVG: %v", err)
+			}
+			m := svgSize.FindSubmatch(data)
+			if m == nil {
+				t.Fatalf("SVG has no size: %.200s", data)
+			}
+			w, _ := strconv.Atoi(string(m[1]))
+			h, _ := strconv.Atoi(string(m[2]))
+			if w != tt.w || h != tt.h {
+				t.Errorf("SVG is %dx%d, want %dx%d", w, h, tt.w, tt.h)
+			}
+		})
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	tests := []struct {
+		name string
+		item *pb.Item
+	}{
+		{"huge", chests(0.5, 1e300)},
+		{"far apart", chests(-1e7, 0, 1e7, 0)},
+		{"infinite", chests(math.Inf(1), 0)},
+		{"NaN", chests(0, math.NaN())},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PNG(tt.item)
+			if err != ErrOutOfRange {
+				t.Errorf("PNG: got error %v, want ErrOutOfRange", err)
+			}
+			_, err = SVG(tt.item)
+			if err != ErrOutOfRange {
+				t.Errorf("SVG: got error %v, want ErrOutOfRange", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package preview
+
+import (
+	"bytes"
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+// pixelsPerTile is the size of a tile in rendered images, unless the image
+// would exceed maxPixels.
+const (
+	pixelsPerTile = 32
+	maxPixels     = 2048
+)
+
+type svgCanvas struct {
+	buf *bytes.Buffer
+}
+
+func rgb(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// num formats x with at most three decimals.
+func num(x float64) string {
+	return strconv.FormatFloat(math.Round(x*1000)/1000, 'f', -1, 64)
+}
+
+func (s svgCanvas) rect(x, y, w, h float64, c color.RGBA) {
+	fmt.Fprintf(s.buf, `<rect x="%s" y="%s" width="%s" height="%s" fill="%s"/>`,
+		num(x), num(y), num(w), num(h), rgb(c))
+}
+
+func (s svgCanvas) line(x1, y1, x2, y2, width float64, c color.RGBA) {
+	fmt.Fprintf(s.buf, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s"/>`,
+		num(x1), num(y1), num(x2), num(y2), rgb(c), num(width))
+}
+
+// scale returns the pixels per tile used for an image of bb, below one for
+// blueprints too large to fit maxPixels otherwise.
+func scale(bb bounds) float64 {
+	size := math.Max(bb.maxX-bb.minX, bb.maxY-bb.minY)
+	return math.Min(pixelsPerTile, maxPixels/size)
+}
+
+// SVG renders the blueprint in item, or the active blueprint of a book.
+func SVG(item *pb.Item) ([]byte, error) {
+	b := target(item)
+	if b == nil || len(b.Entities)+len(b.Tiles) == 0 {
+		return nil, ErrNothingToRender
+	}
+	bb, err := measure(b)
+	if err != nil {
+		return nil, err
+	}
+	s := scale(bb)
+	w, h := bb.maxX-bb.minX, bb.maxY-bb.minY
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="%s %s %s %s" width="%d" height="%d">`,
+		num(bb.minX), num(bb.minY), num(w), num(h), int(math.Ceil(w*s)), int(math.Ceil(h*s)))
+	draw(svgCanvas{buf}, b)
+	buf.WriteString("</svg>")
+	return buf.Bytes(), nil
+}
@@ -15,6 +15,12 @@ type Account struct {
 
 type AccountRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*Account, error)
+	// GetByNickname returns the account whose nickname equals nickname
+	// regardless of case.
+	GetByNickname(ctx context.Context, nickname string) (*Account, error)
+	// Create stores a new account, or fails with AlreadyExists if another
+	// account has the same nickname regardless of case.
+	Create(ctx context.Context, acc *Account) error
 	FromToken(ctx context.Context, token string) (*Account, error)
 }
 
@@ -9,27 +9,178 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/oklog/ulid/v2"
 )
 
 type Item struct {
-	ULID   ulid.ULID
+	ULID ulid.ULID
+	// TimeMs is the creation time of the item, or of the revision it was
+	// queried as.
 	TimeMs uint64
 	Data   []byte
 	// Sum256 should be present when queried without Data.
 	Sum256 *[32]byte
+	// Kind is the top level key of the JSON in Data, e.g. "blueprint".
+	Kind string
+	// Version is the packed game version of the top level object in Data.
+	Version uint64
+	// Original holds the imported JSON if it differs from the canonical form
+	// kept in Data, and keeping it was requested.
+	Original []byte
+	// DeletedMs is the time the item was moved to the trash, if it was.
+	DeletedMs uint64
+	Metadata  Metadata
+	// Revision is the number of the current revision of the item, or of the
+	// revision it was queried as. Revisions are numbered from 1.
+	Revision uint64
+	// Search indexes Data when it is stored by Create or AddRevision.
+	Search *SearchIndex
+}
+
+// SearchIndex holds the text item data is found by in searches.
+type SearchIndex struct {
+	// Text of the whole item, including the entries of books.
+	Text  string
+	Pages []SearchPage
+	// Entities and Recipes used anywhere in the item, for ContentFilter.
+	Entities []string
+	Recipes  []string
+	// Modded is set when an entity is not one of the base game.
+	Modded bool
+}
+
+// SearchPage is an entry of a blueprint book, at any depth, found on its own
+// in searches.
+type SearchPage struct {
+	// Path holds the entry indices leading to the page.
+	Path  []uint64
+	Label string
+	Text  string
+}
+
+// SearchResult is an item matching a search.
+type SearchResult struct {
+	Item *Item
+	Rank float32
+	// Pages of a book matching the search.
+	Pages []PageMatch
+}
+
+// PageMatch is a page of a book matching a search.
+type PageMatch struct {
+	Path  []uint64
+	Label string
+	// Headline is Label with the matching words wrapped in <b> and </b>.
+	Headline string
+}
+
+// Metadata is edited by the owner of an item, independent of its data.
+type Metadata struct {
+	// Title overrides the label found in the data, if not empty.
+	Title string
+	// Description is markdown.
+	Description string
+	Tags        []string
+}
+
+// ListOptions filter, order and limit the items returned by
+// ItemRepository.List. Zero values don't filter.
+type ListOptions struct {
+	MinVersion uint64
+	MaxVersion uint64
+	// FromMs and ToMs limit the creation time of items to [FromMs, ToMs).
+	FromMs uint64
+	ToMs   uint64
+
+	// Descending lists the newest items first.
+	Descending bool
+	// After only lists items after this ULID in the requested order.
+	After ulid.ULID
+	// Limit the number of items listed.
+	Limit int
+	// Tags only lists items having all of them.
+	Tags    []string
+	Content ContentFilter
+}
+
+// ContentFilter matches items by the entities and recipes of their data.
+// Zero values don't filter.
+type ContentFilter struct {
+	// Entities and Recipes only match items containing all of them.
+	Entities []string
+	Recipes  []string
+	// ExcludedEntities only matches items containing none of them.
+	ExcludedEntities []string
+	// NoModded only matches items using base game entities only.
+	NoModded bool
+}
+
+// SearchOptions filter and limit the results of ItemRepository.Search.
+type SearchOptions struct {
+	Content ContentFilter
+	Offset  int
+	Limit   int
 }
 
 type ItemRepository interface {
 	Get(ctx context.Context, accountID uuid.UUID, id ulid.ULID) (*Item, error)
+	// GetData returns the item data with the given sum, if it is referenced by
+	// an item of the account or one of its revisions.
 	GetData(ctx context.Context, accountID uuid.UUID, sum256 [32]byte) ([]byte, error)
 	Create(ctx context.Context, accountID uuid.UUID, item *Item) error
-	List(ctx context.Context, accountID uuid.UUID) ([]*Item, error)
+	// List returns the items matching opts, and the number of items matching
+	// the filters of opts regardless of After and Limit.
+	List(ctx context.Context, accountID uuid.UUID, opts ListOptions) ([]*Item, int, error)
+	// UpdateMetadata sets the fields of the metadata of an item named by
+	// fields, "title", "description" or "tags", to those of md. md is then
+	// set to the updated metadata.
+	UpdateMetadata(ctx context.Context, accountID uuid.UUID, id ulid.ULID, md *Metadata, fields []string) error
+
+	// AddRevision makes item the current revision of the item with the given
+	// id, and sets item.ULID and item.Revision. Content equal to the current
+	// revision doesn't add a revision.
+	AddRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, item *Item) error
+	// ListRevisions returns all revisions of an item without their Data,
+	// oldest first.
+	ListRevisions(ctx context.Context, accountID uuid.UUID, id ulid.ULID) ([]*Item, error)
+	GetRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, revision uint64) (*Item, error)
+	// RevertToRevision adds a revision with the content of an earlier one,
+	// and returns its number.
+	RevertToRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, revision uint64) (uint64, error)
+
+	// Search returns the items matching a web search style query, best
+	// matches first, and the number of matching items regardless of
+	// opts.Offset and opts.Limit.
+	Search(ctx context.Context, accountID uuid.UUID, query string, opts SearchOptions) ([]*SearchResult, int, error)
+
+	// Delete moves an item to the trash of its account. Items in the trash
+	// are excluded from all other methods but ListTrash, Restore and the
+	// purges.
+	Delete(ctx context.Context, accountID uuid.UUID, id ulid.ULID) error
+	Restore(ctx context.Context, accountID uuid.UUID, id ulid.ULID) error
+	ListTrash(ctx context.Context, accountID uuid.UUID) ([]*Item, error)
+	// PurgeTrash removes all items in the trash of an account, and returns
+	// the number of removed items.
+	PurgeTrash(ctx context.Context, accountID uuid.UUID) (int, error)
+	// PurgeExpiredTrash removes the items of all accounts that were moved to
+	// the trash before the given time.
+	PurgeExpiredTrash(ctx context.Context, deletedBefore time.Time) (int, error)
+
+	// CollectGarbage removes item data no longer referenced by any item, and
+	// returns the number of removed blobs and their total size. With dryRun
+	// set, nothing is removed and the reclaimable blobs are reported.
+	CollectGarbage(ctx context.Context, dryRun bool) (int, int64, error)
 }
 
-func (i *Item) Import(s string) error {
+// ErrTooLarge is returned by Import when the inflated data exceeds its limit.
+var ErrTooLarge = errors.New("inflated data too large")
+
+// Import decodes an import string into i.Data. If limit is positive, Import
+// fails with ErrTooLarge instead of inflating more than limit bytes.
+func (i *Item) Import(s string, limit int64) error {
 	if len(s) == 0 {
 		return errors.New("empty import string")
 	}
@@ -45,15 +196,23 @@ func (i *Item) Import(s string) error {
 	if err != nil {
 		return err
 	}
+	var src io.Reader = r
+	if limit > 0 {
+		src = io.LimitReader(r, limit+1)
+	}
 	buf := new(bytes.Buffer)
-	_, err = io.Copy(buf, r)
+	n, err := io.Copy(buf, src)
 	if err != nil {
 		return err
 	}
+	if limit > 0 && n > limit {
+		return ErrTooLarge
+	}
 	i.Data = buf.Bytes()
 	return nil
 }
 
+// Export encodes i.Original, or i.Data if not present, as an import string.
 func (i *Item) Export() (string, error) {
 	s := new(strings.Builder)
 	s.WriteString("0")
@@ -62,7 +221,11 @@ func (i *Item) Export() (string, error) {
 	if err != nil {
 		return "", err
 	}
-	r := bytes.NewReader(i.Data)
+	data := i.Data
+	if i.Original != nil {
+		data = i.Original
+	}
+	r := bytes.NewReader(data)
 	_, err = io.Copy(w, r)
 	if err != nil {
 		return "", err
@@ -0,0 +1,697 @@
+// Package repositorytest checks that implementations of the repository
+// interfaces behave alike.
+package repositorytest
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"api.fabl.app/internal/repository"
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backend is an implementation of the repositories under test.
+type Backend struct {
+	Account repository.AccountRepository
+	Item    repository.ItemRepository
+}
+
+// Run runs the conformance tests against b. Every test uses new accounts
+// and item data, so b may be shared with other tests and runs.
+func Run(t *testing.T, b *Backend) {
+	tests := []struct {
+		name string
+		f    func(*testing.T, *Backend)
+	}{
+		{"Account", testAccount},
+		{"CreateGet", testCreateGet},
+		{"Dedupe", testDedupe},
+		{"Ownership", testOwnership},
+		{"List", testList},
+		{"UpdateMetadata", testUpdateMetadata},
+		{"Revisions", testRevisions},
+		{"Trash", testTrash},
+		{"Search", testSearch},
+		{"CollectGarbage", testCollectGarbage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.f(t, b)
+		})
+	}
+}
+
+func newAccount(t *testing.T, b *Backend) uuid.UUID {
+	t.Helper()
+	acc := &repository.Account{
+		ID:       uuid.New(),
+		Nickname: "test-" + uuid.New().String(),
+	}
+	err := b.Account.Create(context.Background(), acc)
+	if err != nil {
+		t.Fatalf("Create account: %v", err)
+	}
+	return acc.ID
+}
+
+// newItem returns an item with data unique to the test run.
+func newItem(label string, timeMs uint64) *repository.Item {
+	return &repository.Item{
+		TimeMs:  timeMs,
+		Data:    []byte(fmt.Sprintf(`{"blueprint":{"label":%q,"nonce":%q}}`, label, uuid.New())),
+		Kind:    "blueprint",
+		Version: 281479274823680,
+	}
+}
+
+func create(t *testing.T, b *Backend, accountID uuid.UUID, item *repository.Item) *repository.Item {
+	t.Helper()
+	err := b.Item.Create(context.Background(), accountID, item)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	return item
+}
+
+func wantCode(t *testing.T, what string, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Errorf("%s: got error %v, want code %v", what, err, code)
+	}
+}
+
+func ids(items []*repository.Item) []ulid.ULID {
+	v := make([]ulid.ULID, len(items))
+	for i, item := range items {
+		v[i] = item.ULID
+	}
+	return v
+}
+
+func testAccount(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	acc := &repository.Account{
+		ID:             uuid.New(),
+		HashedPassword: []byte("hash"),
+		Nickname:       "test-" + uuid.New().String(),
+	}
+	err := b.Account.Create(ctx, acc)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	got, err := b.Account.Get(ctx, acc.ID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !reflect.DeepEqual(got, acc) {
+		t.Errorf("Get = %+v, want %+v", got, acc)
+	}
+	_, err = b.Account.Get(ctx, uuid.New())
+	wantCode(t, "Get unknown account", err, codes.NotFound)
+
+	got, err = b.Account.GetByNickname(ctx, strings.ToUpper(acc.Nickname))
+	if err != nil {
+		t.Fatalf("GetByNickname: %v", err)
+	}
+	if !reflect.DeepEqual(got, acc) {
+		t.Errorf("GetByNickname = %+v, want %+v", got, acc)
+	}
+	_, err = b.Account.GetByNickname(ctx, "test-"+uuid.New().String())
+	wantCode(t, "GetByNickname unknown account", err, codes.NotFound)
+
+	mixed := &repository.Account{
+		ID:             uuid.New(),
+		HashedPassword: []byte("hash"),
+		Nickname:       "Test-MiXeD-" + strings.ToUpper(uuid.New().String()[:8]),
+	}
+	err = b.Account.Create(ctx, mixed)
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+	for _, nickname := range []string{mixed.Nickname, strings.ToLower(mixed.Nickname), strings.ToUpper(mixed.Nickname)} {
+		got, err = b.Account.GetByNickname(ctx, nickname)
+		if err != nil {
+			t.Errorf("GetByNickname(%q): %v", nickname, err)
+			continue
+		}
+		if got.ID != mixed.ID || got.Nickname != mixed.Nickname {
+			t.Errorf("GetByNickname(%q) = %+v, want %+v", nickname, got, mixed)
+		}
+	}
+
+	err = b.Account.Create(ctx, &repository.Account{
+		ID:             uuid.New(),
+		HashedPassword: []byte("hash"),
+		Nickname:       strings.ToUpper(acc.Nickname),
+	})
+	wantCode(t, "Create with a taken nickname", err, codes.AlreadyExists)
+}
+
+func testCreateGet(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	item := newItem("create", 1600000000000)
+	item.Original = []byte(" original ")
+	create(t, b, accountID, item)
+
+	sum := sha256.Sum256(item.Data)
+	if item.Sum256 == nil || *item.Sum256 != sum {
+		t.Errorf("Sum256 = %x, want %x", item.Sum256, sum)
+	}
+	want, err := ulid.New(item.TimeMs, bytes.NewReader(sum[:]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ULID != want {
+		t.Errorf("ULID = %v, want %v", item.ULID, want)
+	}
+	if item.Revision != 1 {
+		t.Errorf("Revision = %d, want 1", item.Revision)
+	}
+
+	got, err := b.Item.Get(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got.Data, item.Data) || !bytes.Equal(got.Original, item.Original) {
+		t.Errorf("Get returned data %q and original %q", got.Data, got.Original)
+	}
+	if *got.Sum256 != sum || got.Kind != item.Kind || got.Version != item.Version ||
+		got.TimeMs != item.TimeMs || got.Revision != 1 {
+		t.Errorf("Get = %+v, want %+v", got, item)
+	}
+
+	_, err = b.Item.Get(ctx, accountID, ulid.MustNew(item.TimeMs, bytes.NewReader(make([]byte, 10))))
+	wantCode(t, "Get unknown item", err, codes.NotFound)
+
+	now := create(t, b, accountID, newItem("now", 0))
+	if now.TimeMs == 0 || now.ULID.Time() != now.TimeMs {
+		t.Errorf("Create without TimeMs set TimeMs %d and ULID %v", now.TimeMs, now.ULID)
+	}
+}
+
+func testDedupe(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	otherID := newAccount(t, b)
+	a := create(t, b, accountID, newItem("dedupe", 1600000000000))
+	c := create(t, b, accountID, &repository.Item{TimeMs: a.TimeMs + 1, Data: a.Data, Kind: a.Kind, Version: a.Version})
+	d := create(t, b, otherID, &repository.Item{TimeMs: a.TimeMs + 2, Data: a.Data, Kind: a.Kind, Version: a.Version})
+	if a.ULID == c.ULID || a.ULID == d.ULID {
+		t.Errorf("items with the same data share ULID %v", a.ULID)
+	}
+	if *a.Sum256 != *c.Sum256 || *a.Sum256 != *d.Sum256 {
+		t.Errorf("items with the same data have sums %x, %x and %x", *a.Sum256, *c.Sum256, *d.Sum256)
+	}
+
+	// Purging one item keeps the data of the others.
+	err := b.Item.Delete(ctx, accountID, a.ULID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, err = b.Item.PurgeTrash(ctx, accountID)
+	if err != nil {
+		t.Fatalf("PurgeTrash: %v", err)
+	}
+	for _, item := range []*repository.Item{c, d} {
+		accountID := accountID
+		if item == d {
+			accountID = otherID
+		}
+		got, err := b.Item.Get(ctx, accountID, item.ULID)
+		if err != nil {
+			t.Fatalf("Get after purging a duplicate: %v", err)
+		}
+		if !bytes.Equal(got.Data, a.Data) {
+			t.Errorf("Get after purging a duplicate returned data %q", got.Data)
+		}
+	}
+}
+
+func testOwnership(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	otherID := newAccount(t, b)
+	item := create(t, b, accountID, newItem("owned", 1600000000000))
+
+	_, err := b.Item.Get(ctx, otherID, item.ULID)
+	wantCode(t, "Get", err, codes.NotFound)
+	_, err = b.Item.GetData(ctx, otherID, *item.Sum256)
+	wantCode(t, "GetData", err, codes.NotFound)
+	err = b.Item.UpdateMetadata(ctx, otherID, item.ULID, &repository.Metadata{Title: "x"}, []string{"title"})
+	wantCode(t, "UpdateMetadata", err, codes.NotFound)
+	err = b.Item.AddRevision(ctx, otherID, item.ULID, newItem("revision", 0))
+	wantCode(t, "AddRevision", err, codes.NotFound)
+	_, err = b.Item.ListRevisions(ctx, otherID, item.ULID)
+	wantCode(t, "ListRevisions", err, codes.NotFound)
+	_, err = b.Item.GetRevision(ctx, otherID, item.ULID, 1)
+	wantCode(t, "GetRevision", err, codes.NotFound)
+	_, err = b.Item.RevertToRevision(ctx, otherID, item.ULID, 1)
+	wantCode(t, "RevertToRevision", err, codes.NotFound)
+	err = b.Item.Delete(ctx, otherID, item.ULID)
+	wantCode(t, "Delete", err, codes.NotFound)
+
+	items, total, err := b.Item.List(ctx, otherID, repository.ListOptions{})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(items) != 0 || total != 0 {
+		t.Errorf("List of another account returned %d of %d items", len(items), total)
+	}
+
+	err = b.Item.Delete(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	err = b.Item.Restore(ctx, otherID, item.ULID)
+	wantCode(t, "Restore", err, codes.NotFound)
+	n, err := b.Item.PurgeTrash(ctx, otherID)
+	if err != nil || n != 0 {
+		t.Errorf("PurgeTrash of another account = %d, %v", n, err)
+	}
+	trash, err := b.Item.ListTrash(ctx, otherID)
+	if err != nil || len(trash) != 0 {
+		t.Errorf("ListTrash of another account returned %d items, %v", len(trash), err)
+	}
+}
+
+func testList(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	const (
+		start = 1600000000000
+		// version is that of 1.1.48, packed versions overflow 32 bits.
+		version = 281479274823680
+	)
+	var items []*repository.Item
+	for i := 0; i < 5; i++ {
+		item := newItem(fmt.Sprint("list ", i), start+uint64(i)*1000)
+		item.Version = version + uint64(i)<<16
+		items = append(items, create(t, b, accountID, item))
+	}
+	all := ids(items)
+	reversed := make([]ulid.ULID, len(all))
+	for i := range all {
+		reversed[i] = all[len(all)-1-i]
+	}
+	err := b.Item.UpdateMetadata(ctx, accountID, items[1].ULID, &repository.Metadata{Tags: []string{"a", "b"}}, []string{"tags"})
+	if err != nil {
+		t.Fatalf("UpdateMetadata: %v", err)
+	}
+	err = b.Item.UpdateMetadata(ctx, accountID, items[3].ULID, &repository.Metadata{Tags: []string{"a"}}, []string{"tags"})
+	if err != nil {
+		t.Fatalf("UpdateMetadata: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		opts  repository.ListOptions
+		want  []ulid.ULID
+		total int
+	}{
+		{"all", repository.ListOptions{}, all, 5},
+		{"descending", repository.ListOptions{Descending: true}, reversed, 5},
+		{"limit", repository.ListOptions{Limit: 2}, all[:2], 5},
+		{"after", repository.ListOptions{After: all[1], Limit: 2}, all[2:4], 5},
+		{"after descending", repository.ListOptions{After: all[3], Descending: true}, reversed[2:], 5},
+		{"versions", repository.ListOptions{MinVersion: version + 1<<16, MaxVersion: version + 2<<16}, all[1:3], 2},
+		{"min version", repository.ListOptions{MinVersion: version + 3<<16}, all[3:], 2},
+		{"time", repository.ListOptions{FromMs: start + 1000, ToMs: start + 3000}, all[1:3], 2},
+		{"tags", repository.ListOptions{Tags: []string{"a"}}, []ulid.ULID{all[1], all[3]}, 2},
+		{"all tags", repository.ListOptions{Tags: []string{"a", "b"}}, all[1:2], 1},
+	}
+	for _, tt := range tests {
+		got, total, err := b.Item.List(ctx, accountID, tt.opts)
+		if err != nil {
+			t.Errorf("List %s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(ids(got), tt.want) && !(len(got) == 0 && len(tt.want) == 0) {
+			t.Errorf("List %s = %v, want %v", tt.name, ids(got), tt.want)
+		}
+		if total != tt.total {
+			t.Errorf("List %s total = %d, want %d", tt.name, total, tt.total)
+		}
+	}
+
+	got, _, err := b.Item.List(ctx, accountID, repository.ListOptions{Limit: 2})
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if got[0].Data != nil || *got[0].Sum256 != *items[0].Sum256 || got[0].Kind != items[0].Kind ||
+		got[0].Version != items[0].Version || got[0].TimeMs != items[0].TimeMs {
+		t.Errorf("List returned %+v, want %+v without Data", got[0], items[0])
+	}
+	if !reflect.DeepEqual(got[1].Metadata.Tags, []string{"a", "b"}) {
+		t.Errorf("List returned tags %q, want [a b]", got[1].Metadata.Tags)
+	}
+}
+
+func testUpdateMetadata(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	item := create(t, b, accountID, newItem("metadata", 1600000000000))
+
+	md := &repository.Metadata{Title: "Title", Description: "Description", Tags: []string{"z", "a", "z"}}
+	err := b.Item.UpdateMetadata(ctx, accountID, item.ULID, md, []string{"title", "description", "tags"})
+	if err != nil {
+		t.Fatalf("UpdateMetadata: %v", err)
+	}
+	want := repository.Metadata{Title: "Title", Description: "Description", Tags: []string{"a", "z"}}
+	if !reflect.DeepEqual(*md, want) {
+		t.Errorf("UpdateMetadata set %+v, want %+v", *md, want)
+	}
+
+	md = &repository.Metadata{Title: "New title", Description: "ignored"}
+	err = b.Item.UpdateMetadata(ctx, accountID, item.ULID, md, []string{"title"})
+	if err != nil {
+		t.Fatalf("UpdateMetadata: %v", err)
+	}
+	want.Title = "New title"
+	if !reflect.DeepEqual(*md, want) {
+		t.Errorf("UpdateMetadata of the title set %+v, want %+v", *md, want)
+	}
+	got, err := b.Item.Get(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !reflect.DeepEqual(got.Metadata, want) {
+		t.Errorf("Get returned metadata %+v, want %+v", got.Metadata, want)
+	}
+
+	err = b.Item.UpdateMetadata(ctx, accountID, item.ULID, &repository.Metadata{}, []string{"nickname"})
+	if err == nil {
+		t.Errorf("UpdateMetadata of an unknown field succeeded")
+	}
+}
+
+func testRevisions(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	first := create(t, b, accountID, newItem("first", 1600000000000))
+
+	same := &repository.Item{TimeMs: 1600000001000, Data: first.Data, Kind: first.Kind, Version: first.Version}
+	err := b.Item.AddRevision(ctx, accountID, first.ULID, same)
+	if err != nil {
+		t.Fatalf("AddRevision: %v", err)
+	}
+	if same.ULID != first.ULID || same.Revision != 1 {
+		t.Errorf("AddRevision of unchanged content set revision %d of %v", same.Revision, same.ULID)
+	}
+
+	second := newItem("second", 1600000002000)
+	second.Version = 1
+	err = b.Item.AddRevision(ctx, accountID, first.ULID, second)
+	if err != nil {
+		t.Fatalf("AddRevision: %v", err)
+	}
+	if second.ULID != first.ULID || second.Revision != 2 {
+		t.Errorf("AddRevision set revision %d of %v, want 2 of %v", second.Revision, second.ULID, first.ULID)
+	}
+	got, err := b.Item.Get(ctx, accountID, first.ULID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got.Data, second.Data) || got.Revision != 2 || got.TimeMs != first.TimeMs {
+		t.Errorf("Get after AddRevision = %+v", got)
+	}
+
+	rev, err := b.Item.GetRevision(ctx, accountID, first.ULID, 1)
+	if err != nil {
+		t.Fatalf("GetRevision: %v", err)
+	}
+	if !bytes.Equal(rev.Data, first.Data) || rev.Revision != 1 || rev.TimeMs != first.TimeMs {
+		t.Errorf("GetRevision = %+v, want the first revision", rev)
+	}
+	_, err = b.Item.GetRevision(ctx, accountID, first.ULID, 3)
+	wantCode(t, "GetRevision of an unknown revision", err, codes.NotFound)
+	data, err := b.Item.GetData(ctx, accountID, *first.Sum256)
+	if err != nil || !bytes.Equal(data, first.Data) {
+		t.Errorf("GetData of an earlier revision = %q, %v", data, err)
+	}
+
+	n, err := b.Item.RevertToRevision(ctx, accountID, first.ULID, 1)
+	if err != nil {
+		t.Fatalf("RevertToRevision: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("RevertToRevision = %d, want 3", n)
+	}
+	_, err = b.Item.RevertToRevision(ctx, accountID, first.ULID, 4)
+	wantCode(t, "RevertToRevision to an unknown revision", err, codes.NotFound)
+
+	revs, err := b.Item.ListRevisions(ctx, accountID, first.ULID)
+	if err != nil {
+		t.Fatalf("ListRevisions: %v", err)
+	}
+	wantSums := [][32]byte{*first.Sum256, *second.Sum256, *first.Sum256}
+	if len(revs) != len(wantSums) {
+		t.Fatalf("ListRevisions returned %d revisions, want %d", len(revs), len(wantSums))
+	}
+	for i, rev := range revs {
+		if rev.Revision != uint64(i+1) || *rev.Sum256 != wantSums[i] || rev.Data != nil {
+			t.Errorf("revision %d = %+v", i+1, rev)
+		}
+	}
+	if revs[1].TimeMs != second.TimeMs || revs[1].Version != 1 {
+		t.Errorf("revision 2 has time %d and version %d", revs[1].TimeMs, revs[1].Version)
+	}
+	got, err = b.Item.Get(ctx, accountID, first.ULID)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if !bytes.Equal(got.Data, first.Data) || got.Revision != 3 {
+		t.Errorf("Get after RevertToRevision returned revision %d", got.Revision)
+	}
+}
+
+func testTrash(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	item := create(t, b, accountID, newItem("trash", 1600000000000))
+	kept := create(t, b, accountID, newItem("kept", 1600000001000))
+
+	err := b.Item.Delete(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	err = b.Item.Delete(ctx, accountID, item.ULID)
+	wantCode(t, "Delete of a deleted item", err, codes.NotFound)
+	_, err = b.Item.Get(ctx, accountID, item.ULID)
+	wantCode(t, "Get of a deleted item", err, codes.NotFound)
+	_, err = b.Item.GetData(ctx, accountID, *item.Sum256)
+	wantCode(t, "GetData of a deleted item", err, codes.NotFound)
+	items, total, err := b.Item.List(ctx, accountID, repository.ListOptions{})
+	if err != nil || total != 1 || len(items) != 1 || items[0].ULID != kept.ULID {
+		t.Errorf("List after Delete = %v, %d, %v", ids(items), total, err)
+	}
+	trash, err := b.Item.ListTrash(ctx, accountID)
+	if err != nil {
+		t.Fatalf("ListTrash: %v", err)
+	}
+	if len(trash) != 1 || trash[0].ULID != item.ULID || *trash[0].Sum256 != *item.Sum256 || trash[0].DeletedMs == 0 {
+		t.Errorf("ListTrash = %+v", trash)
+	}
+
+	err = b.Item.Restore(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	err = b.Item.Restore(ctx, accountID, item.ULID)
+	wantCode(t, "Restore of a restored item", err, codes.NotFound)
+	_, err = b.Item.Get(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Errorf("Get after Restore: %v", err)
+	}
+
+	err = b.Item.Delete(ctx, accountID, item.ULID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	n, err := b.Item.PurgeTrash(ctx, accountID)
+	if err != nil || n != 1 {
+		t.Errorf("PurgeTrash = %d, %v, want 1", n, err)
+	}
+	err = b.Item.Restore(ctx, accountID, item.ULID)
+	wantCode(t, "Restore of a purged item", err, codes.NotFound)
+	trash, err = b.Item.ListTrash(ctx, accountID)
+	if err != nil || len(trash) != 0 {
+		t.Errorf("ListTrash after PurgeTrash returned %d items, %v", len(trash), err)
+	}
+
+	err = b.Item.Delete(ctx, accountID, kept.ULID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	_, err = b.Item.PurgeExpiredTrash(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeExpiredTrash: %v", err)
+	}
+	trash, err = b.Item.ListTrash(ctx, accountID)
+	if err != nil || len(trash) != 1 {
+		t.Errorf("PurgeExpiredTrash purged an item deleted after the given time")
+	}
+	n, err = b.Item.PurgeExpiredTrash(ctx, time.Now().Add(time.Hour))
+	if err != nil || n < 1 {
+		t.Errorf("PurgeExpiredTrash = %d, %v, want at least 1", n, err)
+	}
+	trash, err = b.Item.ListTrash(ctx, accountID)
+	if err != nil || len(trash) != 0 {
+		t.Errorf("ListTrash after PurgeExpiredTrash returned %d items, %v", len(trash), err)
+	}
+}
+
+func testSearch(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	word := fmt.Sprintf("w%x", uuid.New().ID())
+
+	book := newItem("book", 1600000000000)
+	book.Kind = "blueprint_book"
+	book.Search = &repository.SearchIndex{
+		Text: "solar " + word + " stone furnace accumulator",
+		Pages: []repository.SearchPage{
+			{Path: []uint64{0}, Label: "Smelting", Text: "stone furnace"},
+			{Path: []uint64{1}, Label: "Solar " + word, Text: "solar panel accumulator"},
+			{Path: []uint64{2, 0}, Label: "Nested " + word, Text: "accumulator"},
+		},
+		Entities: []string{"solar-panel", "accumulator", "stone-furnace"},
+		Recipes:  []string{"iron-plate"},
+	}
+	create(t, b, accountID, book)
+	titled := newItem("titled", 1600000001000)
+	titled.Search = &repository.SearchIndex{Text: "belts", Entities: []string{"transport-belt"}, Modded: true}
+	create(t, b, accountID, titled)
+	err := b.Item.UpdateMetadata(ctx, accountID, titled.ULID, &repository.Metadata{Title: "Best " + word}, []string{"title"})
+	if err != nil {
+		t.Fatalf("UpdateMetadata: %v", err)
+	}
+	create(t, b, accountID, newItem("unindexed", 1600000002000))
+
+	results, total, err := b.Item.Search(ctx, accountID, word, repository.SearchOptions{})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if total != 2 || len(results) != 2 {
+		t.Fatalf("Search returned %d of %d results, want 2", len(results), total)
+	}
+	if results[0].Item.ULID != titled.ULID || results[1].Item.ULID != book.ULID {
+		t.Errorf("Search ranked %v before %v, want the title match first", results[0].Item.ULID, results[1].Item.ULID)
+	}
+	if results[0].Rank <= results[1].Rank {
+		t.Errorf("Search ranks = %v, %v", results[0].Rank, results[1].Rank)
+	}
+	if results[0].Item.Metadata.Title != "Best "+word || *results[1].Item.Sum256 != *book.Sum256 {
+		t.Errorf("Search returned items %+v and %+v", results[0].Item, results[1].Item)
+	}
+	wantPages := []repository.PageMatch{
+		{Path: []uint64{1}, Label: "Solar " + word, Headline: "Solar <b>" + word + "</b>"},
+		{Path: []uint64{2, 0}, Label: "Nested " + word, Headline: "Nested <b>" + word + "</b>"},
+	}
+	if !reflect.DeepEqual(results[1].Pages, wantPages) {
+		t.Errorf("Search returned pages %+v, want %+v", results[1].Pages, wantPages)
+	}
+	if len(results[0].Pages) != 0 {
+		t.Errorf("Search returned pages %+v of an item without pages", results[0].Pages)
+	}
+
+	results, total, err = b.Item.Search(ctx, accountID, word, repository.SearchOptions{Offset: 1, Limit: 1})
+	if err != nil || total != 2 || len(results) != 1 || results[0].Item.ULID != book.ULID {
+		t.Errorf("Search of the second page returned %d of %d results, %v", len(results), total, err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		opts  repository.SearchOptions
+		want  []ulid.ULID
+	}{
+		{"excluded", word + " -belts", repository.SearchOptions{}, []ulid.ULID{book.ULID}},
+		{"all words", word + " accumulator", repository.SearchOptions{}, []ulid.ULID{book.ULID}},
+		{"no match", "unmatched" + word, repository.SearchOptions{}, nil},
+		{"entities", word, repository.SearchOptions{Content: repository.ContentFilter{
+			Entities: []string{"solar-panel", "accumulator"},
+		}}, []ulid.ULID{book.ULID}},
+		{"recipes", word, repository.SearchOptions{Content: repository.ContentFilter{
+			Recipes: []string{"iron-plate"},
+		}}, []ulid.ULID{book.ULID}},
+		{"excluded entities", word, repository.SearchOptions{Content: repository.ContentFilter{
+			ExcludedEntities: []string{"stone-furnace"},
+		}}, []ulid.ULID{titled.ULID}},
+		{"no modded", word, repository.SearchOptions{Content: repository.ContentFilter{
+			NoModded: true,
+		}}, []ulid.ULID{book.ULID}},
+	}
+	for _, tt := range tests {
+		results, total, err := b.Item.Search(ctx, accountID, tt.query, tt.opts)
+		if err != nil {
+			t.Errorf("Search %s: %v", tt.name, err)
+			continue
+		}
+		var got []ulid.ULID
+		for _, r := range results {
+			got = append(got, r.Item.ULID)
+		}
+		if !reflect.DeepEqual(got, tt.want) || total != len(tt.want) {
+			t.Errorf("Search %s = %v of %d, want %v", tt.name, got, total, tt.want)
+		}
+	}
+
+	items, total, err := b.Item.List(ctx, accountID, repository.ListOptions{Content: repository.ContentFilter{
+		Entities: []string{"transport-belt"},
+	}})
+	if err != nil || total != 1 || len(items) != 1 || items[0].ULID != titled.ULID {
+		t.Errorf("List by entity = %v of %d, %v", ids(items), total, err)
+	}
+}
+
+func testCollectGarbage(t *testing.T, b *Backend) {
+	ctx := context.Background()
+	accountID := newAccount(t, b)
+	item := create(t, b, accountID, newItem("garbage", 1600000000000))
+	deleted := create(t, b, accountID, newItem("deleted", 1600000001000))
+	err := b.Item.AddRevision(ctx, accountID, item.ULID, newItem("revision", 1600000002000))
+	if err != nil {
+		t.Fatalf("AddRevision: %v", err)
+	}
+	err = b.Item.Delete(ctx, accountID, deleted.ULID)
+	if err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	n, size, err := b.Item.CollectGarbage(ctx, true)
+	if err != nil || n < 0 || size < 0 {
+		t.Fatalf("CollectGarbage dry run = %d, %d, %v", n, size, err)
+	}
+	_, _, err = b.Item.CollectGarbage(ctx, false)
+	if err != nil {
+		t.Fatalf("CollectGarbage: %v", err)
+	}
+	n, size, err = b.Item.CollectGarbage(ctx, true)
+	if err != nil || n != 0 || size != 0 {
+		t.Errorf("CollectGarbage dry run after collecting = %d, %d, %v", n, size, err)
+	}
+
+	// Data of earlier revisions and of items in the trash is referenced.
+	data, err := b.Item.GetData(ctx, accountID, *item.Sum256)
+	if err != nil || !bytes.Equal(data, item.Data) {
+		t.Errorf("GetData of an earlier revision after CollectGarbage = %q, %v", data, err)
+	}
+	err = b.Item.Restore(ctx, accountID, deleted.ULID)
+	if err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	got, err := b.Item.Get(ctx, accountID, deleted.ULID)
+	if err != nil || !bytes.Equal(got.Data, deleted.Data) {
+		t.Errorf("Get of a restored item after CollectGarbage = %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"strings"
+)
+
+// SearchWords splits a web search style query into lower case words to
+// include and to exclude. Phrases and OR are matched as separate words.
+func SearchWords(query string) (include, exclude []string) {
+	for _, word := range strings.Fields(strings.ReplaceAll(query, `"`, " ")) {
+		switch {
+		case word == "OR":
+		case strings.HasPrefix(word, "-"):
+			if word = strings.ToLower(word[1:]); word != "" {
+				exclude = append(exclude, word)
+			}
+		default:
+			include = append(include, strings.ToLower(word))
+		}
+	}
+	return include, exclude
+}
+
+// MatchWords tells if text contains all of the lower case words of include,
+// and none of exclude, ignoring case.
+func MatchWords(text string, include, exclude []string) bool {
+	text = strings.ToLower(text)
+	for _, word := range include {
+		if !strings.Contains(text, word) {
+			return false
+		}
+	}
+	for _, word := range exclude {
+		if strings.Contains(text, word) {
+			return false
+		}
+	}
+	return true
+}
+
+// Highlight wraps the occurrences of the lower case words in s in <b> and
+// </b>, like the ts_headline function of PostgreSQL does.
+func Highlight(s string, words []string) string {
+	lower := strings.ToLower(s)
+	if len(lower) != len(s) {
+		return s
+	}
+	marked := make([]bool, len(s))
+	for _, word := range words {
+		for i := 0; word != ""; {
+			j := strings.Index(lower[i:], word)
+			if j < 0 {
+				break
+			}
+			for k := i + j; k < i+j+len(word); k++ {
+				marked[k] = true
+			}
+			i += j + len(word)
+		}
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if marked[i] && (i == 0 || !marked[i-1]) {
+			b.WriteString("<b>")
+		}
+		if !marked[i] && i > 0 && marked[i-1] {
+			b.WriteString("</b>")
+		}
+		b.WriteByte(s[i])
+	}
+	if len(s) > 0 && marked[len(s)-1] {
+		b.WriteString("</b>")
+	}
+	return b.String()
+}
@@ -2,18 +2,50 @@ package service
 
 import (
 	"context"
+	"crypto/subtle"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"api.fabl.app/internal/repository"
 	"api.fabl.app/internal/session"
 	pb "api.fabl.app/pb/fabl/v1"
 	"github.com/google/uuid"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// AccountServiceConfig holds the settings of an AccountServiceServer. Zero
+// values select the defaults.
+type AccountServiceConfig struct {
+	// BcryptCost is the cost of the password hashes, bcrypt.DefaultCost by
+	// default.
+	BcryptCost int
+	// MinPasswordLength is the minimum length in bytes of a registered
+	// password, 8 by default.
+	MinPasswordLength int
+	// ClosedRegistration rejects every registration.
+	ClosedRegistration bool
+	// InviteCodes, if not empty, are the codes of which one is required to
+	// register.
+	InviteCodes []string
+}
+
+const (
+	defaultMinPasswordLength = 8
+	// maxPasswordLength is the length past which bcrypt ignores passwords.
+	maxPasswordLength = 72
+)
+
+var nicknamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{3,32}$`)
+
 type accountServiceServer struct {
 	repo repository.AccountRepository
+	cfg  AccountServiceConfig
+	// dummyHash is compared to the passwords of unknown accounts.
+	dummyHash []byte
 
 	pb.UnimplementedAccountServiceServer
 }
@@ -53,12 +85,11 @@ func (s *accountServiceServer) CurrentAccount(ctx context.Context, in *pb.Curren
 }
 
 func (s *accountServiceServer) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginResponse, error) {
-	id, err := uuid.Parse(in.Id)
-	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "bad credentials")
-	}
-	acc, err := s.repo.Get(ctx, id)
-	if err != nil {
+	acc, err := s.findAccount(ctx, in)
+	if err != nil || acc.HashedPassword == nil {
+		// Hash the password anyway, so unknown accounts take as long as
+		// wrong passwords.
+		bcrypt.CompareHashAndPassword(s.dummyHash, []byte(in.Password))
 		return nil, status.Error(codes.InvalidArgument, "bad credentials")
 	}
 	if acc.CheckPassword([]byte(in.Password)) != nil {
@@ -71,6 +102,19 @@ func (s *accountServiceServer) Login(ctx context.Context, in *pb.LoginRequest) (
 	return &pb.LoginResponse{}, nil
 }
 
+// findAccount returns the account to log in to, by nickname if given or else
+// by id.
+func (s *accountServiceServer) findAccount(ctx context.Context, in *pb.LoginRequest) (*repository.Account, error) {
+	if in.Nickname != "" {
+		return s.repo.GetByNickname(ctx, in.Nickname)
+	}
+	id, err := uuid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
 func (s *accountServiceServer) Logout(ctx context.Context, in *pb.LogoutRequest) (*pb.LogoutResponse, error) {
 	err := session.Logout(ctx)
 	if err != nil {
@@ -79,9 +123,99 @@ func (s *accountServiceServer) Logout(ctx context.Context, in *pb.LogoutRequest)
 	return &pb.LogoutResponse{}, nil
 }
 
+func (s *accountServiceServer) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.RegisterResponse, error) {
+	if s.cfg.ClosedRegistration {
+		return nil, status.Error(codes.PermissionDenied, "registration is closed")
+	}
+	if len(s.cfg.InviteCodes) > 0 && !s.validInviteCode(in.InviteCode) {
+		return nil, status.Error(codes.PermissionDenied, "invalid invite code")
+	}
+	if !nicknamePattern.MatchString(in.Nickname) {
+		return nil, invalidArgument("nickname", "must be 3 to 32 letters, digits, - or _")
+	}
+	err := s.checkPassword(in.Nickname, in.Password)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(in.Password), s.cfg.BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	acc := &repository.Account{
+		ID:             uuid.New(),
+		HashedPassword: hash,
+		Nickname:       in.Nickname,
+	}
+	err = s.repo.Create(ctx, acc)
+	if err != nil {
+		if status.Code(err) == codes.AlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "nickname is taken")
+		}
+		return nil, err
+	}
+	err = session.Login(ctx, acc.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RegisterResponse{
+		Account: &pb.Account{
+			Id:       acc.ID.String(),
+			Nickname: acc.Nickname,
+		},
+	}, nil
+}
+
+// checkPassword rejects the passwords too weak to register with.
+func (s *accountServiceServer) checkPassword(nickname, password string) error {
+	switch {
+	case len(password) < s.cfg.MinPasswordLength:
+		return invalidArgument("password", fmt.Sprintf("must be at least %d bytes long", s.cfg.MinPasswordLength))
+	case len(password) > maxPasswordLength:
+		return invalidArgument("password", fmt.Sprintf("must be at most %d bytes long", maxPasswordLength))
+	case strings.EqualFold(password, nickname):
+		return invalidArgument("password", "must differ from the nickname")
+	case repeatsOneRune(password):
+		return invalidArgument("password", "must not repeat a single character")
+	}
+	return nil
+}
+
+// repeatsOneRune tells if s is a single character repeated.
+func repeatsOneRune(s string) bool {
+	runes := []rune(s)
+	for _, r := range runes {
+		if r != runes[0] {
+			return false
+		}
+	}
+	return len(runes) > 0
+}
+
+// validInviteCode tells if code is one of the invite codes, in a time not
+// depending on which one.
+func (s *accountServiceServer) validInviteCode(code string) bool {
+	valid := 0
+	for _, c := range s.cfg.InviteCodes {
+		valid |= subtle.ConstantTimeCompare([]byte(c), []byte(code))
+	}
+	return code != "" && valid == 1
+}
+
 // NewAccountServiceServer initializes an AccountServiceServer.
-func NewAccountServiceServer(store repository.AccountRepository) pb.AccountServiceServer {
-	return &accountServiceServer{
-		repo: store,
+func NewAccountServiceServer(store repository.AccountRepository, cfg AccountServiceConfig) (pb.AccountServiceServer, error) {
+	if cfg.BcryptCost == 0 {
+		cfg.BcryptCost = bcrypt.DefaultCost
+	}
+	if cfg.MinPasswordLength <= 0 {
+		cfg.MinPasswordLength = defaultMinPasswordLength
 	}
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("dummy password"), cfg.BcryptCost)
+	if err != nil {
+		return nil, err
+	}
+	return &accountServiceServer{
+		repo:      store,
+		cfg:       cfg,
+		dummyHash: dummyHash,
+	}, nil
 }
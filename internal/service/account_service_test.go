@@ -0,0 +1,94 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"api.fabl.app/internal/memory"
+	pb "api.fabl.app/pb/fabl/v1"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      AccountServiceConfig
+		nickname string
+		password string
+		invite   string
+		want     codes.Code
+	}{
+		{"valid", AccountServiceConfig{}, "alice_2-b", "correct horse", "", codes.OK},
+		{"nickname too short", AccountServiceConfig{}, "al", "correct horse", "", codes.InvalidArgument},
+		{"nickname of 32 characters", AccountServiceConfig{}, strings.Repeat("a", 32), "correct horse", "", codes.OK},
+		{"nickname too long", AccountServiceConfig{}, strings.Repeat("a", 33), "correct horse", "", codes.InvalidArgument},
+		{"nickname with a space", AccountServiceConfig{}, "alice b", "correct horse", "", codes.InvalidArgument},
+		{"nickname with a non-ASCII letter", AccountServiceConfig{}, "alicé", "correct horse", "", codes.InvalidArgument},
+		{"password too short", AccountServiceConfig{}, "alice", "1234567", "", codes.InvalidArgument},
+		{"password of the minimum length", AccountServiceConfig{}, "alice", "12345678", "", codes.OK},
+		{"configured minimum length", AccountServiceConfig{MinPasswordLength: 12}, "alice", "correct hors", "", codes.OK},
+		{"under the configured minimum length", AccountServiceConfig{MinPasswordLength: 12}, "alice", "correct hor", "", codes.InvalidArgument},
+		{"password of 72 bytes", AccountServiceConfig{}, "alice", strings.Repeat("ab", 36), "", codes.OK},
+		{"password too long", AccountServiceConfig{}, "alice", strings.Repeat("ab", 36) + "c", "", codes.InvalidArgument},
+		{"password equal to the nickname", AccountServiceConfig{}, "alice_in_chains", "Alice_In_Chains", "", codes.InvalidArgument},
+		{"password repeating one character", AccountServiceConfig{}, "alice", "aaaaaaaa", "", codes.InvalidArgument},
+		{"password repeating one multi-byte character", AccountServiceConfig{}, "alice", "ééééé", "", codes.InvalidArgument},
+		{"closed registration", AccountServiceConfig{ClosedRegistration: true}, "alice", "correct horse", "", codes.PermissionDenied},
+		{"invite code", AccountServiceConfig{InviteCodes: []string{"a1", "b2"}}, "alice", "correct horse", "b2", codes.OK},
+		{"missing invite code", AccountServiceConfig{InviteCodes: []string{"a1", "b2"}}, "alice", "correct horse", "", codes.PermissionDenied},
+		{"invalid invite code", AccountServiceConfig{InviteCodes: []string{"a1", "b2"}}, "alice", "correct horse", "c3", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.BcryptCost = bcrypt.MinCost
+			s, err := NewAccountServiceServer(memory.NewRepository().Account, tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := s.Register(newContext(t, uuid.Nil), &pb.RegisterRequest{
+				Nickname:   tt.nickname,
+				Password:   tt.password,
+				InviteCode: tt.invite,
+			})
+			if status.Code(err) != tt.want {
+				t.Fatalf("Register: %v, want %v", err, tt.want)
+			}
+			if err == nil && res.Account.Nickname != tt.nickname {
+				t.Errorf("registered nickname %q, want %q", res.Account.Nickname, tt.nickname)
+			}
+		})
+	}
+}
+
+func TestRegisterTakenNickname(t *testing.T) {
+	s, err := NewAccountServiceServer(memory.NewRepository().Account, AccountServiceConfig{BcryptCost: bcrypt.MinCost})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := newContext(t, uuid.Nil)
+	_, err = s.Register(ctx, &pb.RegisterRequest{Nickname: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.Register(newContext(t, uuid.Nil), &pb.RegisterRequest{Nickname: "Alice", Password: "battery staple"})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Register with a taken nickname: %v, want AlreadyExists", err)
+	}
+
+	// Registering logs in, and the nickname logs in again.
+	res, err := s.CurrentAccount(ctx, &pb.CurrentAccountRequest{})
+	if err != nil || res.Account.GetNickname() != "alice" {
+		t.Errorf("CurrentAccount after Register = %v, %v", res, err)
+	}
+	_, err = s.Login(newContext(t, uuid.Nil), &pb.LoginRequest{Nickname: "ALICE", Password: "correct horse"})
+	if err != nil {
+		t.Errorf("Login by nickname: %v", err)
+	}
+	_, err = s.Login(newContext(t, uuid.Nil), &pb.LoginRequest{Nickname: "alice", Password: "battery staple"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Login with a wrong password: %v, want InvalidArgument", err)
+	}
+}
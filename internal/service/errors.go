@@ -0,0 +1,25 @@
+package service
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// invalidArgument returns an InvalidArgument error carrying a BadRequest
+// detail for field.
+func invalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description))
+	st, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+			Field:       field,
+			Description: description,
+		}},
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return st.Err()
+}
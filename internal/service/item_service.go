@@ -1,20 +1,143 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
+	"api.fabl.app/internal/blueprint"
 	"api.fabl.app/internal/repository"
 	"api.fabl.app/internal/session"
 	pb "api.fabl.app/pb/fabl/v1"
 	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// ItemServiceConfig holds the settings of an ItemServiceServer. Zero values
+// disable the corresponding limit.
+type ItemServiceConfig struct {
+	// MaxImportSize is the maximum length of an import string.
+	MaxImportSize int
+	// MaxDataSize is the maximum size of the inflated JSON of an import string.
+	MaxDataSize int64
+	// MaxGameVersion is the newest supported game version. Imports of newer
+	// versions are flagged, or rejected if RejectNewerGameVersions is set.
+	MaxGameVersion          blueprint.Version
+	RejectNewerGameVersions bool
+	// TrashRetention is how long deleted items are kept in the trash, zero
+	// keeps them until the trash is purged.
+	TrashRetention time.Duration
+	// KeepOriginal keeps the imported JSON next to its canonical form, so
+	// Export returns exactly what was imported.
+	KeepOriginal bool
+}
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
+)
+
+var itemKinds = map[string]pb.ItemKind{
+	blueprint.KindBlueprint:             pb.ItemKind_ITEM_KIND_BLUEPRINT,
+	blueprint.KindBlueprintBook:         pb.ItemKind_ITEM_KIND_BLUEPRINT_BOOK,
+	blueprint.KindDeconstructionPlanner: pb.ItemKind_ITEM_KIND_DECONSTRUCTION_PLANNER,
+	blueprint.KindUpgradePlanner:        pb.ItemKind_ITEM_KIND_UPGRADE_PLANNER,
+}
+
 type itemServiceServer struct {
 	repo repository.ItemRepository
+	cfg  ItemServiceConfig
 
 	pb.UnimplementedItemServiceServer
 }
 
+func (s *itemServiceServer) BillOfMaterials(ctx context.Context, in *pb.BillOfMaterialsRequest) (*pb.BillOfMaterialsResponse, error) {
+	decoded, err := s.getDecoded(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		total blueprint.Materials
+		pages []*pb.BillOfMaterialsResponse_Page
+	)
+	blueprint.Walk(decoded, func(path []uint64, item *pb.Item) {
+		b := item.GetBlueprint()
+		if b == nil {
+			return
+		}
+		var m blueprint.Materials
+		m.AddBlueprint(b)
+		total.Add(&m)
+		if in.PerPage {
+			pages = append(pages, &pb.BillOfMaterialsResponse_Page{
+				Path:      append([]uint64(nil), path...),
+				Label:     b.Label,
+				Materials: materialsProto(&m),
+			})
+		}
+	})
+	return &pb.BillOfMaterialsResponse{
+		Total: materialsProto(&total),
+		Pages: pages,
+	}, nil
+}
+
+func materialsProto(m *blueprint.Materials) *pb.BillOfMaterialsResponse_Materials {
+	return &pb.BillOfMaterialsResponse_Materials{
+		Items:    m.Items,
+		Entities: m.Entities,
+		Tiles:    m.Tiles,
+		Modules:  m.Modules,
+	}
+}
+
+func (s *itemServiceServer) Delete(ctx context.Context, in *pb.DeleteRequest) (*pb.DeleteResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.Delete(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteResponse{}, nil
+}
+
+func (s *itemServiceServer) Diff(ctx context.Context, in *pb.DiffRequest) (*pb.DiffResponse, error) {
+	from, err := s.getDecoded(ctx, in.FromId)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getDecoded(ctx, in.ToId)
+	if err != nil {
+		return nil, err
+	}
+	fromKind, fromLabel, fromIcons := blueprint.Summary(from)
+	toKind, toLabel, toIcons := blueprint.Summary(to)
+	res := &pb.DiffResponse{
+		FromKind:     itemKinds[fromKind],
+		ToKind:       itemKinds[toKind],
+		FromLabel:    fromLabel,
+		ToLabel:      toLabel,
+		IconsChanged: !blueprint.IconsEqual(fromIcons, toIcons),
+	}
+	switch {
+	case from.GetBlueprint() != nil && to.GetBlueprint() != nil:
+		res.Blueprint = blueprint.DiffBlueprints(from.GetBlueprint(), to.GetBlueprint())
+	case from.GetBlueprintBook() != nil && to.GetBlueprintBook() != nil:
+		res.Pages = blueprint.DiffBooks(from.GetBlueprintBook(), to.GetBlueprintBook())
+	}
+	return res, nil
+}
+
 func (s *itemServiceServer) Export(ctx context.Context, in *pb.ExportRequest) (*pb.ExportResponse, error) {
 	accountID, err := session.Account(ctx)
 	if err != nil {
@@ -50,28 +173,149 @@ func (s *itemServiceServer) Get(ctx context.Context, in *pb.GetRequest) (*pb.Get
 	if err != nil {
 		return nil, err
 	}
+	// Data that is not a recognized Factorio object is still returned raw.
+	decoded, err := blueprint.Decode(item.Data)
+	if err != nil && !errors.Is(err, blueprint.ErrUnrecognized) {
+		return nil, err
+	}
 	return &pb.GetResponse{
-		Data: item.Data,
+		Data:     item.Data,
+		Item:     decoded,
+		Metadata: metadataProto(item.Metadata),
+		Revision: item.Revision,
 	}, nil
 }
 
+func (s *itemServiceServer) GetData(ctx context.Context, in *pb.GetDataRequest) (*pb.GetDataResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var sum256 [32]byte
+	if len(in.Sum) != len(sum256) {
+		return nil, invalidArgument("sum", "must be a sha256 sum of 32 bytes")
+	}
+	copy(sum256[:], in.Sum)
+	data, err := s.repo.GetData(ctx, accountID, sum256)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetDataResponse{
+		Data: data,
+	}, nil
+}
+
+func (s *itemServiceServer) GetRevision(ctx context.Context, in *pb.GetRevisionRequest) (*pb.GetRevisionResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repo.GetRevision(ctx, accountID, id, in.Revision)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := blueprint.Decode(item.Data)
+	if err != nil && !errors.Is(err, blueprint.ErrUnrecognized) {
+		return nil, err
+	}
+	return &pb.GetRevisionResponse{
+		Data:   item.Data,
+		Item:   decoded,
+		TimeMs: item.TimeMs,
+	}, nil
+}
+
+func (s *itemServiceServer) GetTree(ctx context.Context, in *pb.GetTreeRequest) (*pb.GetTreeResponse, error) {
+	decoded, err := s.getDecoded(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTreeResponse{
+		Root: treeNode(0, decoded),
+	}, nil
+}
+
+func treeNode(index uint64, item *pb.Item) *pb.GetTreeResponse_Node {
+	kind, label, icons := blueprint.Summary(item)
+	n := &pb.GetTreeResponse_Node{
+		Index: index,
+		Kind:  itemKinds[kind],
+		Label: label,
+		Icons: icons,
+	}
+	if book := item.GetBlueprintBook(); book != nil {
+		for _, e := range book.Blueprints {
+			n.Children = append(n.Children, treeNode(e.Index, blueprint.EntryItem(e)))
+		}
+	}
+	return n
+}
+
 func (s *itemServiceServer) Import(ctx context.Context, in *pb.ImportRequest) (*pb.ImportResponse, error) {
 	accountID, err := session.Account(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if s.cfg.MaxImportSize > 0 && len(in.ImportString) > s.cfg.MaxImportSize {
+		return nil, invalidArgument("import_string", fmt.Sprintf("longer than %d bytes", s.cfg.MaxImportSize))
+	}
 	item := &repository.Item{TimeMs: in.TimeMs}
-	err = item.Import(in.ImportString)
+	err = item.Import(in.ImportString, s.cfg.MaxDataSize)
+	if err == repository.ErrTooLarge {
+		return nil, invalidArgument("import_string", fmt.Sprintf("inflates to more than %d bytes", s.cfg.MaxDataSize))
+	} else if err != nil {
+		return nil, invalidArgument("import_string", err.Error())
+	}
+	err = blueprint.Validate(item.Data)
+	if err != nil {
+		return nil, invalidArgument("import_string", err.Error())
+	}
+	item.Kind, err = blueprint.Kind(item.Data)
 	if err != nil {
 		return nil, err
 	}
-	// TODO: more validation here, see history for example.
-	err = s.repo.Create(ctx, accountID, item)
+	decoded, err := blueprint.Decode(item.Data)
+	if err != nil {
+		return nil, invalidArgument("import_string", err.Error())
+	}
+	version := blueprint.ItemVersion(decoded)
+	newer := s.cfg.MaxGameVersion != 0 && version > s.cfg.MaxGameVersion
+	if newer && s.cfg.RejectNewerGameVersions {
+		return nil, invalidArgument("import_string", fmt.Sprintf("game version %s is newer than supported", version))
+	}
+	item.Version = uint64(version)
+	canonical, err := blueprint.Canonicalize(item.Data)
 	if err != nil {
 		return nil, err
 	}
+	if s.cfg.KeepOriginal && !bytes.Equal(canonical, item.Data) {
+		item.Original = item.Data
+	}
+	item.Data = canonical
+	item.Search = searchIndex(decoded)
+	if in.RevisionOf != "" {
+		id, err := ulid.Parse(in.RevisionOf)
+		if err != nil {
+			return nil, invalidArgument("revision_of", err.Error())
+		}
+		err = s.repo.AddRevision(ctx, accountID, id, item)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		err = s.repo.Create(ctx, accountID, item)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &pb.ImportResponse{
-		Id: item.ULID.String(),
+		Id:               item.ULID.String(),
+		NewerGameVersion: newer,
+		Revision:         item.Revision,
 	}, nil
 }
 
@@ -83,25 +327,232 @@ func (s *itemServiceServer) List(ctx context.Context, in *pb.ListRequest) (*pb.L
 			Items: []*pb.ListResponse_Item{},
 		}, nil
 	}
-	items, err := s.repo.List(ctx, accountID)
+	var opts repository.ListOptions
+	if in.MinGameVersion != "" {
+		lo, _, err := blueprint.ParseVersion(in.MinGameVersion)
+		if err != nil {
+			return nil, invalidArgument("min_game_version", err.Error())
+		}
+		opts.MinVersion = uint64(lo)
+	}
+	if in.MaxGameVersion != "" {
+		_, hi, err := blueprint.ParseVersion(in.MaxGameVersion)
+		if err != nil {
+			return nil, invalidArgument("max_game_version", err.Error())
+		}
+		opts.MaxVersion = uint64(hi)
+	}
+	if in.PageToken != "" {
+		opts.After, err = ulid.Parse(in.PageToken)
+		if err != nil {
+			return nil, invalidArgument("page_token", err.Error())
+		}
+	}
+	pageSize := int(in.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	// Ask for one more item, to know if there is a next page.
+	opts.Limit = pageSize + 1
+	opts.Descending = in.NewestFirst
+	opts.FromMs = in.CreatedAfterMs
+	opts.ToMs = in.CreatedBeforeMs
+	for _, tag := range in.Tags {
+		opts.Tags = append(opts.Tags, strings.TrimSpace(tag))
+	}
+	opts.Content = contentFilter(in.Content)
+	items, total, err := s.repo.List(ctx, accountID, opts)
 	if err != nil {
 		return nil, err
 	}
+	var nextPageToken string
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		nextPageToken = items[pageSize-1].ULID.String()
+	}
 	pbItems := make([]*pb.ListResponse_Item, len(items))
 	for i, item := range items {
-		pbItems[i] = &pb.ListResponse_Item{
-			Id:  item.ULID.String(),
-			Sum: item.Sum256[:],
-		}
+		pbItems[i] = listItem(item)
 	}
 	return &pb.ListResponse{
+		Items:         pbItems,
+		NextPageToken: nextPageToken,
+		TotalSize:     uint64(total),
+	}, nil
+}
+
+func listItem(item *repository.Item) *pb.ListResponse_Item {
+	return &pb.ListResponse_Item{
+		Id:          item.ULID.String(),
+		Sum:         item.Sum256[:],
+		Kind:        itemKinds[item.Kind],
+		GameVersion: blueprint.Version(item.Version).Proto(),
+		Metadata:    metadataProto(item.Metadata),
+	}
+}
+
+func (s *itemServiceServer) ListRevisions(ctx context.Context, in *pb.ListRevisionsRequest) (*pb.ListRevisionsResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := s.repo.ListRevisions(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	pbRevisions := make([]*pb.ListRevisionsResponse_Revision, len(revisions))
+	for i, rev := range revisions {
+		pbRevisions[i] = &pb.ListRevisionsResponse_Revision{
+			Revision:    rev.Revision,
+			TimeMs:      rev.TimeMs,
+			Sum:         rev.Sum256[:],
+			Kind:        itemKinds[rev.Kind],
+			GameVersion: blueprint.Version(rev.Version).Proto(),
+		}
+	}
+	return &pb.ListRevisionsResponse{
+		Revisions: pbRevisions,
+	}, nil
+}
+
+func (s *itemServiceServer) ListTrash(ctx context.Context, in *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.repo.ListTrash(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	pbItems := make([]*pb.ListTrashResponse_Item, len(items))
+	for i, item := range items {
+		pbItems[i] = &pb.ListTrashResponse_Item{
+			Id:        item.ULID.String(),
+			Sum:       item.Sum256[:],
+			Kind:      itemKinds[item.Kind],
+			DeletedMs: item.DeletedMs,
+		}
+		if s.cfg.TrashRetention > 0 {
+			pbItems[i].PurgeMs = item.DeletedMs + uint64(s.cfg.TrashRetention/time.Millisecond)
+		}
+	}
+	return &pb.ListTrashResponse{
 		Items: pbItems,
 	}, nil
 }
 
+func (s *itemServiceServer) PurgeTrash(ctx context.Context, in *pb.PurgeTrashRequest) (*pb.PurgeTrashResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.repo.PurgeTrash(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PurgeTrashResponse{
+		Purged: uint64(n),
+	}, nil
+}
+
+func (s *itemServiceServer) Restore(ctx context.Context, in *pb.RestoreRequest) (*pb.RestoreResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.Restore(ctx, accountID, id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreResponse{}, nil
+}
+
+func (s *itemServiceServer) RevertToRevision(ctx context.Context, in *pb.RevertToRevisionRequest) (*pb.RevertToRevisionResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.repo.RevertToRevision(ctx, accountID, id, in.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevertToRevisionResponse{
+		Revision: revision,
+	}, nil
+}
+
+func (s *itemServiceServer) UpdateItem(ctx context.Context, in *pb.UpdateItemRequest) (*pb.UpdateItemResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := ulid.Parse(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	fields := in.GetUpdateMask().GetPaths()
+	if len(fields) == 0 {
+		fields = metadataFields
+	}
+	for _, field := range fields {
+		if !contains(metadataFields, field) {
+			return nil, invalidArgument("update_mask", fmt.Sprintf("unknown field %q", field))
+		}
+	}
+	md, err := metadataFromProto(in.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.UpdateMetadata(ctx, accountID, id, &md, fields)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateItemResponse{
+		Metadata: metadataProto(md),
+	}, nil
+}
+
+// getDecoded gets the item with the given id and decodes its data.
+func (s *itemServiceServer) getDecoded(ctx context.Context, id string) (*pb.Item, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	itemID, err := ulid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.repo.Get(ctx, accountID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := blueprint.Decode(item.Data)
+	if errors.Is(err, blueprint.ErrUnrecognized) {
+		return nil, status.Error(codes.FailedPrecondition, "item is not a recognized Factorio object")
+	} else if err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
 // NewItemServiceServer initializes an ItemServiceServer.
-func NewItemServiceServer(repo repository.ItemRepository) pb.ItemServiceServer {
+func NewItemServiceServer(repo repository.ItemRepository, cfg ItemServiceConfig) pb.ItemServiceServer {
 	return &itemServiceServer{
 		repo: repo,
+		cfg:  cfg,
 	}
 }
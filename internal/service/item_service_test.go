@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"api.fabl.app/internal/memory"
+	"api.fabl.app/internal/repository"
+	"api.fabl.app/internal/session"
+	pb "api.fabl.app/pb/fabl/v1"
+	"github.com/google/uuid"
+	"github.com/gorilla/sessions"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newContext returns a context with a new session, logged in to accountID
+// unless it is uuid.Nil.
+func newContext(t *testing.T, accountID uuid.UUID) context.Context {
+	t.Helper()
+	ctx := session.NewContext(context.Background(), sessions.NewSession(nil, "session"))
+	if accountID != uuid.Nil {
+		err := session.Login(ctx, accountID)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return ctx
+}
+
+func TestDiff(t *testing.T) {
+	repo := memory.NewRepository()
+	accountID := uuid.New()
+	ctx := newContext(t, accountID)
+	create := func(data string) string {
+		item := &repository.Item{Data: []byte(data)}
+		err := repo.Item.Create(ctx, accountID, item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return item.ULID.String()
+	}
+	var (
+		chest = create(`{"blueprint":{"item":"blueprint","label":"Chest","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":0.5}}]}}`)
+		chests = create(`{"blueprint":{"item":"blueprint","label":"Chests","version":1,"entities":[
+			{"entity_number":1,"name":"wooden-chest","position":{"x":0.5,"y":0.5}},
+			{"entity_number":2,"name":"wooden-chest","position":{"x":1.5,"y":0.5}}]}}`)
+		book = create(`{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":0,"blueprint":{"item":"blueprint","label":"Chest","version":1}}]}}`)
+		movedBook = create(`{"blueprint_book":{"item":"blueprint-book","version":1,"blueprints":[
+			{"index":3,"blueprint":{"item":"blueprint","label":"Chest","version":1}}]}}`)
+		unrecognized = create(`{"blueprint_tag":{}}`)
+	)
+	s := NewItemServiceServer(repo.Item, ItemServiceConfig{})
+
+	res, err := s.Diff(ctx, &pb.DiffRequest{FromId: chest, ToId: chests})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.FromLabel != "Chest" || res.ToLabel != "Chests" || res.ToKind != pb.ItemKind_ITEM_KIND_BLUEPRINT {
+		t.Errorf("Diff of blueprints = %v", res)
+	}
+	if n := len(res.Blueprint.GetAddedEntities()); n != 1 || res.Pages != nil {
+		t.Errorf("Diff of blueprints has %d added entities and pages %v, want 1 and none", n, res.Pages)
+	}
+
+	res, err = s.Diff(ctx, &pb.DiffRequest{FromId: book, ToId: movedBook})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Pages) != 1 || res.Pages[0].Type != pb.DiffResponse_PageChange_TYPE_MOVED || res.Blueprint != nil {
+		t.Errorf("Diff of books = %v, want one moved page", res)
+	}
+
+	res, err = s.Diff(ctx, &pb.DiffRequest{FromId: chest, ToId: book})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ToKind != pb.ItemKind_ITEM_KIND_BLUEPRINT_BOOK || res.Blueprint != nil || res.Pages != nil {
+		t.Errorf("Diff of a blueprint and a book = %v, want only their summaries", res)
+	}
+
+	_, err = s.Diff(ctx, &pb.DiffRequest{FromId: chest, ToId: unrecognized})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Diff with an unrecognized item: %v, want FailedPrecondition", err)
+	}
+	_, err = s.Diff(newContext(t, uuid.New()), &pb.DiffRequest{FromId: chest, ToId: chests})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Diff of another account's items: %v, want NotFound", err)
+	}
+	_, err = s.Diff(newContext(t, uuid.Nil), &pb.DiffRequest{FromId: chest, ToId: chests})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Diff without logging in: %v, want Unauthenticated", err)
+	}
+}
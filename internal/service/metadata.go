@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"api.fabl.app/internal/repository"
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+const (
+	maxTitleLength       = 200
+	maxDescriptionLength = 16 << 10
+	maxTagLength         = 64
+	maxTags              = 32
+)
+
+// metadataFields are the update_mask paths accepted by UpdateItem.
+var metadataFields = []string{"title", "description", "tags"}
+
+func contains(a []string, s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func metadataProto(md repository.Metadata) *pb.ItemMetadata {
+	return &pb.ItemMetadata{
+		Title:       md.Title,
+		Description: md.Description,
+		Tags:        md.Tags,
+	}
+}
+
+// metadataFromProto validates in, and returns it with its title trimmed and
+// its tags trimmed, deduplicated and sorted.
+func metadataFromProto(in *pb.ItemMetadata) (repository.Metadata, error) {
+	md := repository.Metadata{
+		Title:       strings.TrimSpace(in.GetTitle()),
+		Description: in.GetDescription(),
+	}
+	if utf8.RuneCountInString(md.Title) > maxTitleLength {
+		return md, invalidArgument("metadata.title", fmt.Sprintf("longer than %d characters", maxTitleLength))
+	}
+	if utf8.RuneCountInString(md.Description) > maxDescriptionLength {
+		return md, invalidArgument("metadata.description", fmt.Sprintf("longer than %d characters", maxDescriptionLength))
+	}
+	seen := make(map[string]bool)
+	for _, tag := range in.GetTags() {
+		tag = strings.TrimSpace(tag)
+		switch {
+		case tag == "":
+			return md, invalidArgument("metadata.tags", "empty tag")
+		case utf8.RuneCountInString(tag) > maxTagLength:
+			return md, invalidArgument("metadata.tags", fmt.Sprintf("tag longer than %d characters", maxTagLength))
+		case seen[tag]:
+			continue
+		}
+		seen[tag] = true
+		md.Tags = append(md.Tags, tag)
+	}
+	if len(md.Tags) > maxTags {
+		return md, invalidArgument("metadata.tags", fmt.Sprintf("more than %d tags", maxTags))
+	}
+	sort.Strings(md.Tags)
+	return md, nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"api.fabl.app/internal/blueprint"
+	"api.fabl.app/internal/repository"
+	"api.fabl.app/internal/session"
+	pb "api.fabl.app/pb/fabl/v1"
+)
+
+// maxSearchText is the maximum size of the text item data is indexed by, as
+// PostgreSQL limits the size of a text search document to 1 MiB.
+const maxSearchText = 512 << 10
+
+func (s *itemServiceServer) Search(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	accountID, err := session.Account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(in.Query) == "" {
+		return nil, invalidArgument("query", "empty")
+	}
+	var offset int
+	if in.PageToken != "" {
+		offset, err = strconv.Atoi(in.PageToken)
+		if err != nil || offset < 0 {
+			return nil, invalidArgument("page_token", "malformed")
+		}
+	}
+	pageSize := int(in.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	results, total, err := s.repo.Search(ctx, accountID, in.Query, repository.SearchOptions{
+		Content: contentFilter(in.Content),
+		Offset:  offset,
+		Limit:   pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var nextPageToken string
+	if offset+len(results) < total {
+		nextPageToken = strconv.Itoa(offset + len(results))
+	}
+	pbResults := make([]*pb.SearchResponse_Result, len(results))
+	for i, result := range results {
+		pbResults[i] = &pb.SearchResponse_Result{
+			Item: listItem(result.Item),
+			Rank: result.Rank,
+		}
+		for _, page := range result.Pages {
+			pbResults[i].Pages = append(pbResults[i].Pages, &pb.SearchResponse_Page{
+				Path:     page.Path,
+				Label:    page.Label,
+				Headline: page.Headline,
+			})
+		}
+	}
+	return &pb.SearchResponse{
+		Results:       pbResults,
+		NextPageToken: nextPageToken,
+		TotalSize:     uint64(total),
+	}, nil
+}
+
+// SearchIndex returns the search index of stored item data, for data stored
+// before it was indexed. Data that doesn't decode gets an empty index.
+func SearchIndex(data []byte) *repository.SearchIndex {
+	item, err := blueprint.Decode(data)
+	if err != nil {
+		return &repository.SearchIndex{}
+	}
+	return searchIndex(item)
+}
+
+// contentFilter converts in, which may be nil.
+func contentFilter(in *pb.ContentFilter) repository.ContentFilter {
+	return repository.ContentFilter{
+		Entities:         in.GetEntities(),
+		Recipes:          in.GetRecipes(),
+		ExcludedEntities: in.GetExcludedEntities(),
+		NoModded:         in.GetNoModdedEntities(),
+	}
+}
+
+// searchIndex returns the text item is found by in searches, that of every
+// page of books, and the entities and recipes it is filtered by.
+func searchIndex(item *pb.Item) *repository.SearchIndex {
+	var (
+		index = &repository.SearchIndex{}
+		text  strings.Builder
+		seen  = make(map[string]bool)
+		names = make(map[string]bool)
+	)
+	blueprint.Walk(item, func(path []uint64, item *pb.Item) {
+		for _, e := range item.GetBlueprint().GetEntities() {
+			if !names["entity:"+e.Name] {
+				names["entity:"+e.Name] = true
+				index.Entities = append(index.Entities, e.Name)
+				if _, ok := blueprint.Prototypes[e.Name]; !ok {
+					index.Modded = true
+				}
+			}
+			if e.Recipe != "" && !names["recipe:"+e.Recipe] {
+				names["recipe:"+e.Recipe] = true
+				index.Recipes = append(index.Recipes, e.Recipe)
+			}
+		}
+		pageText := blueprint.SearchText(item)
+		for _, line := range strings.Split(pageText, "\n") {
+			if seen[line] || text.Len()+len(line) >= maxSearchText {
+				continue
+			}
+			seen[line] = true
+			text.WriteString(line)
+			text.WriteByte('\n')
+		}
+		if len(path) == 0 {
+			return
+		}
+		_, label, _ := blueprint.Summary(item)
+		if len(pageText) > maxSearchText {
+			pageText = pageText[:strings.LastIndexByte(pageText[:maxSearchText], '\n')+1]
+		}
+		index.Pages = append(index.Pages, repository.SearchPage{
+			Path:  append([]uint64(nil), path...),
+			Label: label,
+			Text:  pageText,
+		})
+	})
+	index.Text = text.String()
+	return index
+}
@@ -60,6 +60,12 @@ func (m *middleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// NewContext returns a copy of ctx holding session, as Wrap does for the
+// requests it serves.
+func NewContext(ctx context.Context, session *sessions.Session) context.Context {
+	return context.WithValue(ctx, ckSession, session)
+}
+
 func Account(ctx context.Context) (uuid.UUID, error) {
 	session, ok := ctx.Value(ckSession).(*sessions.Session)
 	if !ok {
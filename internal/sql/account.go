@@ -2,6 +2,8 @@ package sql
 
 import (
 	"context"
+	"database/sql"
+	"strings"
 
 	"api.fabl.app/internal/repository"
 	"github.com/google/uuid"
@@ -15,6 +17,20 @@ type accountRepo struct {
 }
 
 func (r *accountRepo) Get(ctx context.Context, id uuid.UUID) (*repository.Account, error) {
+	w := &where{}
+	w.add("id = ?", id)
+	return r.get(ctx, w)
+}
+
+func (r *accountRepo) GetByNickname(ctx context.Context, nickname string) (*repository.Account, error) {
+	w := &where{}
+	// Nicknames are ASCII, which lower folds like strings.ToLower.
+	w.add("lower(nickname) = ?", strings.ToLower(nickname))
+	return r.get(ctx, w)
+}
+
+// get returns the account matching w.
+func (r *accountRepo) get(ctx context.Context, w *where) (*repository.Account, error) {
 	var acc struct {
 		ID             uuid.UUID `db:"id"`
 		HashedPassword []byte    `db:"hashed_password"`
@@ -26,14 +42,35 @@ func (r *accountRepo) Get(ctx context.Context, id uuid.UUID) (*repository.Accoun
 		FROM
 			account
 		WHERE
-			id = $1;`,
-		id,
+			`+w.String()+`;`,
+		w.args...,
 	)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "account not found")
+	}
+	if err != nil {
+		return nil, err
+	}
 	return &repository.Account{
 		ID:             acc.ID,
 		HashedPassword: acc.HashedPassword,
 		Nickname:       acc.Nickname,
-	}, err
+	}, nil
+}
+
+func (r *accountRepo) Create(ctx context.Context, acc *repository.Account) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT
+		INTO
+			account (id, hashed_password, nickname)
+		VALUES
+			($1, $2, $3);`,
+		acc.ID, acc.HashedPassword, acc.Nickname,
+	)
+	if isUniqueViolation(err) {
+		return status.Error(codes.AlreadyExists, "account already exists")
+	}
+	return err
 }
 
 func (r *accountRepo) FromToken(ctx context.Context, token string) (*repository.Account, error) {
@@ -0,0 +1,142 @@
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+
+	"api.fabl.app/internal/blob"
+	"github.com/jmoiron/sqlx"
+)
+
+// MoveDataToBlobStore moves the item data kept in item_data to blobs, in
+// transactions of batchSize rows, and returns the number of moved blobs.
+func MoveDataToBlobStore(ctx context.Context, db *sqlx.DB, blobs blob.Store, batchSize int) (int, error) {
+	w := &where{}
+	w.add("external = ?", false)
+	return eachData(ctx, db, batchSize, w, func(tx *sqlx.Tx, sum [32]byte, row *storedData) error {
+		err := blobs.Put(ctx, row.key(sum[:]), row.Data)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE
+				item_data
+			SET
+				item_data = $1, external = TRUE
+			WHERE
+				sum256 = $2;`,
+			[]byte{}, sum[:],
+		)
+		return err
+	}, nil)
+}
+
+// MoveDataToDatabase moves the item data kept in blobs back to item_data, in
+// transactions of batchSize rows, and returns the number of moved blobs. The
+// blobs are left in the store.
+func MoveDataToDatabase(ctx context.Context, db *sqlx.DB, blobs blob.Store, batchSize int) (int, error) {
+	w := &where{}
+	w.add("external = ?", true)
+	return eachData(ctx, db, batchSize, w, func(tx *sqlx.Tx, sum [32]byte, row *storedData) error {
+		stored, err := blobs.Get(ctx, row.key(sum[:]))
+		if err != nil {
+			return fmt.Errorf("failed to get item data %x: %w", sum, err)
+		}
+		data, err := decodeData(row.Codec, stored)
+		if err != nil {
+			return err
+		}
+		if sha256.Sum256(data) != sum {
+			return fmt.Errorf("blob %x doesn't match its sum", sum)
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE
+				item_data
+			SET
+				item_data = $1, external = FALSE
+			WHERE
+				sum256 = $2;`,
+			stored, sum[:],
+		)
+		return err
+	}, nil)
+}
+
+// eachData calls f for each item_data row matching w, locked in transactions
+// of batchSize rows, and returns the number of rows. committed, if not nil,
+// is called after each transaction commits.
+func eachData(ctx context.Context, db *sqlx.DB, batchSize int, w *where, f func(tx *sqlx.Tx, sum [32]byte, row *storedData) error, committed func() error) (int, error) {
+	if batchSize <= 0 {
+		batchSize = gcBatchSize
+	}
+	var (
+		count int
+		after []byte
+	)
+	for {
+		batch := &where{
+			conds: append([]string(nil), w.conds...),
+			args:  append([]interface{}(nil), w.args...),
+		}
+		if after != nil {
+			batch.add("sum256 > ?", after)
+		}
+		n, last, err := eachDataBatch(ctx, db, batchSize, batch, f)
+		if err != nil {
+			return count, err
+		}
+		count += n
+		if committed != nil {
+			err = committed()
+			if err != nil {
+				return count, err
+			}
+		}
+		if n < batchSize {
+			return count, nil
+		}
+		after = last
+	}
+}
+
+// eachDataBatch is a transaction of eachData, it returns the sum of the last
+// row.
+func eachDataBatch(ctx context.Context, db *sqlx.DB, batchSize int, w *where, f func(tx *sqlx.Tx, sum [32]byte, row *storedData) error) (int, []byte, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer tx.Rollback()
+	var rows []*struct {
+		Sum256 []byte `db:"sum256"`
+		storedData
+	}
+	err = tx.SelectContext(ctx, &rows, `
+		SELECT
+			sum256, item_data, external, codec
+		FROM
+			item_data
+		WHERE
+			`+w.String()+`
+		ORDER BY
+			sum256
+		LIMIT
+			`+strconv.Itoa(batchSize)+`
+		`+lockRows(tx, "FOR UPDATE")+`;`,
+		w.args...,
+	)
+	if err != nil || len(rows) == 0 {
+		return 0, nil, err
+	}
+	for _, row := range rows {
+		var sum [32]byte
+		copy(sum[:], row.Sum256)
+		err = f(tx, sum, &row.storedData)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return len(rows), rows[len(rows)-1].Sum256, tx.Commit()
+}
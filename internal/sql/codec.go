@@ -0,0 +1,183 @@
+package sql
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"io"
+
+	"api.fabl.app/internal/blob"
+	"github.com/jmoiron/sqlx"
+)
+
+// Codecs of item_data.codec, the encoding of the stored item data.
+const (
+	codecNone = ""
+	codecZlib = "zlib"
+)
+
+// dataCodec encodes inserted item data.
+const dataCodec = codecZlib
+
+func encodeData(codec string, data []byte) ([]byte, error) {
+	switch codec {
+	case codecNone:
+		return data, nil
+	case codecZlib:
+		buf := new(bytes.Buffer)
+		w, err := zlib.NewWriterLevel(buf, zlib.BestCompression)
+		if err != nil {
+			return nil, err
+		}
+		_, err = w.Write(data)
+		if err != nil {
+			return nil, err
+		}
+		err = w.Close()
+		if err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown item data codec %q", codec)
+	}
+}
+
+func decodeData(codec string, data []byte) ([]byte, error) {
+	switch codec {
+	case codecNone:
+		return data, nil
+	case codecZlib:
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	default:
+		return nil, fmt.Errorf("unknown item data codec %q", codec)
+	}
+}
+
+// storedData holds the columns of item_data needed to load the data.
+type storedData struct {
+	Data     []byte `db:"item_data"`
+	External bool   `db:"external"`
+	Codec    string `db:"codec"`
+}
+
+// key returns the key of the data in the blob store.
+func (d *storedData) key(sum256 []byte) blob.Key {
+	key := blob.Key{Codec: d.Codec}
+	copy(key.Sum256[:], sum256)
+	return key
+}
+
+// DataStats tells how much item data is stored, and how much space its
+// encoding saves.
+type DataStats struct {
+	// Blobs counts the stored item data by codec, "" for unencoded data.
+	Blobs map[string]int
+	// External is the number of blobs kept in the blob store.
+	External int
+	// Size is the length of the item data, and StoredSize the length of the
+	// stored encoded data.
+	Size       int64
+	StoredSize int64
+}
+
+// GetDataStats returns the DataStats of all item data.
+func GetDataStats(ctx context.Context, db *sqlx.DB) (*DataStats, error) {
+	var v []struct {
+		Codec      string `db:"codec"`
+		Count      int    `db:"count"`
+		External   int    `db:"external"`
+		Size       int64  `db:"size"`
+		StoredSize int64  `db:"stored_size"`
+	}
+	err := db.SelectContext(ctx, &v, `
+		SELECT
+			codec, COUNT(*) AS count,
+			COALESCE(SUM(CASE WHEN external THEN 1 ELSE 0 END), 0) AS external,
+			COALESCE(SUM(size), 0) AS size, COALESCE(SUM(stored_size), 0) AS stored_size
+		FROM
+			item_data
+		GROUP BY
+			codec;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	stats := &DataStats{Blobs: make(map[string]int)}
+	for _, c := range v {
+		stats.Blobs[c.Codec] = c.Count
+		stats.External += c.External
+		stats.Size += c.Size
+		stats.StoredSize += c.StoredSize
+	}
+	return stats, nil
+}
+
+// RecodeData encodes the stored item data not encoded with codec, "zlib" or
+// "" to decode it, in transactions of batchSize rows, and returns the number
+// of encoded blobs. The blobs of data kept in the blob store are replaced
+// once the rows referencing them are committed.
+func RecodeData(ctx context.Context, db *sqlx.DB, blobs blob.Store, codec string, batchSize int) (int, error) {
+	_, err := encodeData(codec, nil)
+	if err != nil {
+		return 0, err
+	}
+	var replaced []blob.Key
+	w := &where{}
+	w.add("codec <> ?", codec)
+	return eachData(ctx, db, batchSize, w, func(tx *sqlx.Tx, sum [32]byte, row *storedData) error {
+		stored := row.Data
+		if row.External {
+			if blobs == nil {
+				return errNoBlobStore
+			}
+			var err error
+			stored, err = blobs.Get(ctx, row.key(sum[:]))
+			if err != nil {
+				return fmt.Errorf("failed to get item data %x: %w", sum, err)
+			}
+		}
+		data, err := decodeData(row.Codec, stored)
+		if err != nil {
+			return err
+		}
+		encoded, err := encodeData(codec, data)
+		if err != nil {
+			return err
+		}
+		inDatabase := encoded
+		if row.External {
+			err = blobs.Put(ctx, blob.Key{Sum256: sum, Codec: codec}, encoded)
+			if err != nil {
+				return err
+			}
+			replaced = append(replaced, row.key(sum[:]))
+			inDatabase = []byte{}
+		}
+		_, err = tx.ExecContext(ctx, `
+			UPDATE
+				item_data
+			SET
+				item_data = $1, codec = $2, stored_size = $3
+			WHERE
+				sum256 = $4;`,
+			inDatabase, codec, len(encoded), sum[:],
+		)
+		return err
+	}, func() error {
+		for _, key := range replaced {
+			err := blobs.Delete(ctx, key)
+			if err != nil {
+				return err
+			}
+		}
+		replaced = replaced[:0]
+		return nil
+	})
+}
@@ -0,0 +1,134 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+// Driver names of the supported databases.
+const (
+	postgresDriver = "postgres"
+	sqliteDriver   = "fabl-sqlite3"
+)
+
+func init() {
+	sql.Register(sqliteDriver, &numberedDriver{})
+}
+
+// Connect connects to the database of dsn. A dsn starting with "sqlite:"
+// opens the SQLite database at the following path, e.g. "sqlite:fabl.db" or
+// "sqlite::memory:". Any other dsn is that of a PostgreSQL database.
+func Connect(dsn string) (*sqlx.DB, error) {
+	path, ok := sqlitePath(dsn)
+	if !ok {
+		return sqlx.Connect(postgresDriver, dsn)
+	}
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	db, err := sqlx.Connect(sqliteDriver, path+sep+"_foreign_keys=1&_txlock=immediate&_busy_timeout=5000")
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer, and an in-memory database only exists
+	// for the connection that made it.
+	db.SetMaxOpenConns(1)
+	return db, nil
+}
+
+// sqlitePath returns the path of the SQLite database of dsn, if it is one.
+func sqlitePath(dsn string) (string, bool) {
+	for _, scheme := range []string{"sqlite3:", "sqlite:"} {
+		if strings.HasPrefix(dsn, scheme) {
+			path := strings.TrimPrefix(dsn, scheme)
+			// sqlite:///var/lib/fabl.db is the absolute path /var/lib/fabl.db.
+			return strings.TrimPrefix(path, "//"), true
+		}
+	}
+	return "", false
+}
+
+// isSQLite tells if q queries an SQLite database.
+func isSQLite(q interface{ DriverName() string }) bool {
+	return q.DriverName() == sqliteDriver
+}
+
+// isUniqueViolation tells if err is the violation of a unique constraint.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == "23505"
+	}
+	var sqliteErr sqlite3.Error
+	if errors.As(err, &sqliteErr) {
+		return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique ||
+			sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	}
+	return false
+}
+
+// lockRows returns clause, e.g. "FOR UPDATE", to lock the selected rows, or
+// nothing for SQLite, whose transactions lock the whole database.
+func lockRows(q interface{ DriverName() string }, clause string) string {
+	if isSQLite(q) {
+		return ""
+	}
+	return clause
+}
+
+// numberedDriver is the SQLite driver accepting the $1 placeholders of
+// PostgreSQL. SQLite numbers $ parameters by their first appearance instead
+// of their name, so they are rewritten to ?1.
+type numberedDriver struct {
+	sqlite3.SQLiteDriver
+}
+
+func (d *numberedDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &numberedConn{conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+type numberedConn struct {
+	*sqlite3.SQLiteConn
+}
+
+var dollarParam = regexp.MustCompile(`\$(\d+)`)
+
+func numbered(query string) string {
+	return dollarParam.ReplaceAllString(query, "?${1}")
+}
+
+func (c *numberedConn) Prepare(query string) (driver.Stmt, error) {
+	return c.SQLiteConn.Prepare(numbered(query))
+}
+
+func (c *numberedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	return c.SQLiteConn.PrepareContext(ctx, numbered(query))
+}
+
+func (c *numberedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.SQLiteConn.ExecContext(ctx, numbered(query), args)
+}
+
+func (c *numberedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return c.SQLiteConn.QueryContext(ctx, numbered(query), args)
+}
+
+// queryer is implemented by *sqlx.DB and *sqlx.Tx.
+type queryer interface {
+	sqlx.QueryerContext
+	DriverName() string
+	Rebind(query string) string
+}
@@ -5,7 +5,12 @@ import (
 	"context"
 	"crypto/sha256"
 	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
+	"api.fabl.app/internal/blob"
 	"api.fabl.app/internal/repository"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -16,56 +21,71 @@ import (
 
 type itemRepo struct {
 	db *sqlx.DB
+	// blobs keeps newly inserted item data, if set.
+	blobs blob.Store
 }
 
 func (r *itemRepo) Get(ctx context.Context, accountID uuid.UUID, id ulid.ULID) (*repository.Item, error) {
 	v := struct {
-		Data      []byte    `db:"item_data"`
 		AccountID uuid.UUID `db:"account_id"`
+		Sum256    []byte    `db:"sum256"`
+		Kind      string    `db:"kind"`
+		Version   int64     `db:"version"`
+		Original  []byte    `db:"original_data"`
+		Revision  int64     `db:"revision"`
+		storedData
+		metadata
 	}{}
 	err := r.db.GetContext(ctx, &v, `
 		SELECT
-			item_data, account_id
+			item_data, external, codec, account_id, item.sum256, COALESCE(kind, '') AS kind,
+			COALESCE(version, 0) AS version, original_data,
+			COALESCE(title, '') AS title, COALESCE(description, '') AS description,
+			(SELECT COALESCE(MAX(revision), 1) FROM item_revision WHERE item_id = item.id) AS revision
 		FROM
 			item
 			INNER JOIN item_data ON item.sum256 = item_data.sum256
 		WHERE
-			account_id = $1 AND id = $2;
+			account_id = $1 AND id = $2 AND deleted_at IS NULL;
 	`, accountID, id)
-	return &repository.Item{
-		ULID:   id,
-		TimeMs: id.Time(),
-		Data:   v.Data,
-	}, err
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.loadData(ctx, v.Sum256, &v.storedData)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := itemTags(ctx, r.db, id)
+	if err != nil {
+		return nil, err
+	}
+	item := &repository.Item{
+		ULID:     id,
+		TimeMs:   id.Time(),
+		Data:     data,
+		Sum256:   new([32]byte),
+		Kind:     v.Kind,
+		Version:  uint64(v.Version),
+		Original: v.Original,
+		Metadata: v.withTags(tags[id]),
+		Revision: uint64(v.Revision),
+	}
+	copy(item.Sum256[:], v.Sum256)
+	return item, nil
 }
 
 func (r *itemRepo) Create(ctx context.Context, accountID uuid.UUID, item *repository.Item) error {
-	tx, err := r.db.Beginx()
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	var v []byte
-	err = tx.GetContext(ctx, &v, `
-		INSERT
-		INTO
-			item_data (item_data)
-		VALUES
-			($1)
-		ON CONFLICT
-		DO
-			NOTHING
-		RETURNING
-			sum256;`,
-		item.Data,
-	)
-	if err == sql.ErrNoRows {
-		sum256 := sha256.Sum256(item.Data)
-		item.Sum256 = &sum256
-	} else if err != nil {
+	defer tx.Rollback()
+	err = r.insertData(ctx, tx, item)
+	if err != nil {
 		return err
-	} else {
-		item.Sum256 = new([32]byte)
-		copy((*item.Sum256)[:], v)
 	}
 	if item.TimeMs == 0 {
 		item.TimeMs = ulid.Now()
@@ -77,14 +97,19 @@ func (r *itemRepo) Create(ctx context.Context, accountID uuid.UUID, item *reposi
 	_, err = tx.ExecContext(ctx, `
 		INSERT
 		INTO
-			item (id, sum256, account_id)
+			item (id, sum256, account_id, original_data)
 		VALUES
-			($1, $2, $3);`,
-		item.ULID[:], item.Sum256[:], accountID,
+			($1, $2, $3, $4);`,
+		item.ULID[:], item.Sum256[:], accountID, item.Original,
 	)
 	if err != nil {
 		return err
 	}
+	item.Revision = 1
+	err = insertRevision(ctx, tx, item)
+	if err != nil {
+		return err
+	}
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -92,14 +117,431 @@ func (r *itemRepo) Create(ctx context.Context, accountID uuid.UUID, item *reposi
 	return nil
 }
 
-func (r *itemRepo) List(ctx context.Context, accountID uuid.UUID) ([]*repository.Item, error) {
+// insertData inserts item.Data unless already present and sets item.Sum256.
+// The item_data row stays locked until tx ends, so it can't be collected as
+// garbage before an item references it. item.Search is stored unless the data
+// was already indexed.
+//
+// With a blob store, the data is put in it even if present, in case the
+// garbage collection that removed it rolled back after deleting the blob.
+func (r *itemRepo) insertData(ctx context.Context, tx *sqlx.Tx, item *repository.Item) error {
+	sum256 := sha256.Sum256(item.Data)
+	item.Sum256 = &sum256
+	encoded, err := encodeData(dataCodec, item.Data)
+	if err != nil {
+		return err
+	}
+	inDatabase := encoded
+	if r.blobs != nil {
+		inDatabase = []byte{}
+	}
+	for {
+		_, err = tx.ExecContext(ctx, `
+			INSERT
+			INTO
+				item_data (sum256, item_data, kind, version, external, size, codec, stored_size)
+			VALUES
+				($1, $2, $3, $4, $5, $6, $7, $8)
+			ON CONFLICT
+			DO
+				NOTHING;`,
+			sum256[:], inDatabase, item.Kind, int64(item.Version), r.blobs != nil,
+			len(item.Data), dataCodec, len(encoded),
+		)
+		if err != nil {
+			return err
+		}
+		var v storedData
+		err = tx.GetContext(ctx, &v, `
+			SELECT
+				external, codec
+			FROM
+				item_data
+			WHERE
+				sum256 = $1
+			`+lockRows(tx, "FOR SHARE")+`;`,
+			sum256[:],
+		)
+		if err == sql.ErrNoRows {
+			// Collected as garbage between both statements, insert again.
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if v.External {
+			if r.blobs == nil {
+				return errNoBlobStore
+			}
+			if v.Codec != dataCodec {
+				encoded, err = encodeData(v.Codec, item.Data)
+				if err != nil {
+					return err
+				}
+			}
+			err = r.blobs.Put(ctx, v.key(sum256[:]), encoded)
+			if err != nil {
+				return err
+			}
+		}
+		if item.Search == nil {
+			return nil
+		}
+		return indexData(ctx, tx, sum256[:], item.Search)
+	}
+}
+
+// errNoBlobStore is returned when item data is kept in a blob store that
+// isn't configured.
+var errNoBlobStore = errors.New("item data is kept in a blob store, which isn't configured")
+
+// loadData returns the decoded item data of a row of item_data, reading it
+// from the blob store if kept there.
+func (r *itemRepo) loadData(ctx context.Context, sum256 []byte, v *storedData) ([]byte, error) {
+	stored := v.Data
+	if v.External {
+		if r.blobs == nil {
+			return nil, errNoBlobStore
+		}
+		var err error
+		stored, err = r.blobs.Get(ctx, v.key(sum256))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get item data %x: %w", sum256, err)
+		}
+	}
+	return decodeData(v.Codec, stored)
+}
+
+func (r *itemRepo) List(ctx context.Context, accountID uuid.UUID, opts repository.ListOptions) ([]*repository.Item, int, error) {
+	w := &where{}
+	w.add("account_id = ?", accountID)
+	w.add("deleted_at IS NULL")
+	if opts.MinVersion != 0 {
+		w.add("version >= ?", int64(opts.MinVersion))
+	}
+	if opts.MaxVersion != 0 {
+		w.add("version <= ?", int64(opts.MaxVersion))
+	}
+	if opts.FromMs != 0 {
+		var from ulid.ULID
+		err := from.SetTime(opts.FromMs)
+		if err != nil {
+			return nil, 0, err
+		}
+		w.add("id >= ?", from[:])
+	}
+	if opts.ToMs != 0 {
+		var to ulid.ULID
+		err := to.SetTime(opts.ToMs)
+		if err != nil {
+			return nil, 0, err
+		}
+		w.add("id < ?", to[:])
+	}
+	for _, tag := range opts.Tags {
+		w.add("EXISTS (SELECT 1 FROM item_tag WHERE item_tag.item_id = item.id AND tag = ?)", tag)
+	}
+	addContentFilter(w, opts.Content)
+
+	var total int
+	err := r.db.GetContext(ctx, &total, `
+		SELECT
+			COUNT(*)
+		FROM
+			item
+			INNER JOIN item_data ON item.sum256 = item_data.sum256
+		WHERE
+			`+w.String()+`;`,
+		w.args...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	order := "ASC"
+	if opts.Descending {
+		order = "DESC"
+	}
+	if opts.After != (ulid.ULID{}) {
+		if opts.Descending {
+			w.add("id < ?", opts.After[:])
+		} else {
+			w.add("id > ?", opts.After[:])
+		}
+	}
+	var limit string
+	if opts.Limit > 0 {
+		limit = "LIMIT " + strconv.Itoa(opts.Limit)
+	}
 	var v []*struct {
 		ULID      ulid.ULID `db:"id"`
 		AccountID uuid.UUID `db:"account_id"`
 		Sum256    []byte    `db:"sum256"`
+		Kind      string    `db:"kind"`
+		Version   int64     `db:"version"`
+		metadata
+	}
+	err = r.db.SelectContext(ctx, &v, `
+		SELECT
+			id, account_id, item.sum256, COALESCE(kind, '') AS kind,
+			COALESCE(version, 0) AS version,
+			COALESCE(title, '') AS title, COALESCE(description, '') AS description
+		FROM
+			item
+			INNER JOIN item_data ON item.sum256 = item_data.sum256
+		WHERE
+			`+w.String()+`
+		ORDER BY
+			id `+order+`
+		`+limit+`;`,
+		w.args...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]ulid.ULID, len(v))
+	for i, item := range v {
+		ids[i] = item.ULID
+	}
+	tags, err := itemTags(ctx, r.db, ids...)
+	if err != nil {
+		return nil, 0, err
+	}
+	items := make([]*repository.Item, len(v))
+	for i, item := range v {
+		items[i] = &repository.Item{
+			ULID:     item.ULID,
+			TimeMs:   item.ULID.Time(),
+			Sum256:   new([32]byte),
+			Kind:     item.Kind,
+			Version:  uint64(item.Version),
+			Metadata: item.withTags(tags[item.ULID]),
+		}
+		copy(items[i].Sum256[:], item.Sum256)
+	}
+	return items, total, nil
+}
+
+func (r *itemRepo) UpdateMetadata(ctx context.Context, accountID uuid.UUID, id ulid.ULID, md *repository.Metadata, fields []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	var v metadata
+	err = tx.GetContext(ctx, &v, `
+		SELECT
+			COALESCE(title, '') AS title, COALESCE(description, '') AS description
+		FROM
+			item
+		WHERE
+			account_id = $1 AND id = $2 AND deleted_at IS NULL
+		`+lockRows(tx, "FOR UPDATE")+`;`,
+		accountID, id,
+	)
+	if err == sql.ErrNoRows {
+		return status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		switch field {
+		case "title":
+			v.Title = md.Title
+		case "description":
+			v.Description = md.Description
+		case "tags":
+			err = setItemTags(ctx, tx, id, md.Tags)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown metadata field %q", field)
+		}
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE
+			item
+		SET
+			title = $1, description = $2
+		WHERE
+			id = $3;`,
+		v.Title, v.Description, id,
+	)
+	if err != nil {
+		return err
+	}
+	tags, err := itemTags(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	*md = v.withTags(tags[id])
+	return nil
+}
+
+// metadata holds the metadata columns of an item, its tags are kept in the
+// item_tag table.
+type metadata struct {
+	Title       string `db:"title"`
+	Description string `db:"description"`
+}
+
+func (m metadata) withTags(tags []string) repository.Metadata {
+	return repository.Metadata{
+		Title:       m.Title,
+		Description: m.Description,
+		Tags:        tags,
+	}
+}
+
+// itemTags returns the tags of the given items, sorted, by item.
+func itemTags(ctx context.Context, q queryer, ids ...ulid.ULID) (map[ulid.ULID][]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	b := make([][]byte, len(ids))
+	for i := range ids {
+		b[i] = ids[i][:]
+	}
+	var v []struct {
+		ItemID ulid.ULID `db:"item_id"`
+		Tag    string    `db:"tag"`
+	}
+	query, args, err := sqlx.In(`
+		SELECT
+			item_id, tag
+		FROM
+			item_tag
+		WHERE
+			item_id IN (?)
+		ORDER BY
+			tag;`,
+		b,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = sqlx.SelectContext(ctx, q, &v, q.Rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[ulid.ULID][]string)
+	for _, t := range v {
+		tags[t.ItemID] = append(tags[t.ItemID], t.Tag)
+	}
+	return tags, nil
+}
+
+// setItemTags replaces the tags of an item.
+func setItemTags(ctx context.Context, tx *sqlx.Tx, id ulid.ULID, tags []string) error {
+	_, err := tx.ExecContext(ctx, `
+		DELETE
+		FROM
+			item_tag
+		WHERE
+			item_id = $1;`,
+		id,
+	)
+	if err != nil {
+		return err
+	}
+	for _, tag := range tags {
+		_, err = tx.ExecContext(ctx, `
+			INSERT
+			INTO
+				item_tag (item_id, tag)
+			VALUES
+				($1, $2)
+			ON CONFLICT
+			DO
+				NOTHING;`,
+			id, tag,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *itemRepo) GetData(ctx context.Context, accountID uuid.UUID, sum256 [32]byte) ([]byte, error) {
+	var v storedData
+	err := r.db.GetContext(ctx, &v, `
+		SELECT
+			item_data, external, codec
+		FROM
+			item_data
+		WHERE
+			sum256 = $1
+			AND (
+				EXISTS (
+					SELECT 1 FROM item
+					WHERE item.sum256 = item_data.sum256 AND account_id = $2 AND deleted_at IS NULL
+				)
+				OR EXISTS (
+					SELECT 1 FROM item_revision INNER JOIN item ON item_revision.item_id = item.id
+					WHERE item_revision.sum256 = item_data.sum256 AND account_id = $2 AND deleted_at IS NULL
+				)
+			);`,
+		sum256[:], accountID,
+	)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "data not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return r.loadData(ctx, sum256[:], &v)
+}
+
+func (r *itemRepo) Delete(ctx context.Context, accountID uuid.UUID, id ulid.ULID) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE
+			item
+		SET
+			deleted_at = $1
+		WHERE
+			account_id = $2 AND id = $3 AND deleted_at IS NULL;`,
+		time.Now(), accountID, id[:],
+	)
+	return notFoundIfNone(res, err)
+}
+
+func (r *itemRepo) Restore(ctx context.Context, accountID uuid.UUID, id ulid.ULID) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE
+			item
+		SET
+			deleted_at = NULL
+		WHERE
+			account_id = $1 AND id = $2 AND deleted_at IS NOT NULL;`,
+		accountID, id[:],
+	)
+	return notFoundIfNone(res, err)
+}
+
+func (r *itemRepo) ListTrash(ctx context.Context, accountID uuid.UUID) ([]*repository.Item, error) {
+	var v []*struct {
+		ULID      ulid.ULID `db:"id"`
+		Sum256    []byte    `db:"sum256"`
+		Kind      string    `db:"kind"`
+		DeletedAt time.Time `db:"deleted_at"`
 	}
-	err := r.db.SelectContext(ctx, &v,
-		`SELECT id, account_id, sum256 FROM item WHERE account_id = $1 ORDER BY id`,
+	err := r.db.SelectContext(ctx, &v, `
+		SELECT
+			id, item.sum256, COALESCE(kind, '') AS kind, deleted_at
+		FROM
+			item
+			INNER JOIN item_data ON item.sum256 = item_data.sum256
+		WHERE
+			account_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY
+			deleted_at DESC;`,
 		accountID,
 	)
 	if err != nil {
@@ -108,15 +550,247 @@ func (r *itemRepo) List(ctx context.Context, accountID uuid.UUID) ([]*repository
 	items := make([]*repository.Item, len(v))
 	for i, item := range v {
 		items[i] = &repository.Item{
-			ULID:   item.ULID,
-			TimeMs: item.ULID.Time(),
-			Sum256: new([32]byte),
+			ULID:      item.ULID,
+			TimeMs:    item.ULID.Time(),
+			Sum256:    new([32]byte),
+			Kind:      item.Kind,
+			DeletedMs: uint64(item.DeletedAt.UnixNano() / int64(time.Millisecond)),
 		}
 		copy(items[i].Sum256[:], item.Sum256)
 	}
-	return items, err
+	return items, nil
 }
 
-func (r *itemRepo) GetData(ctx context.Context, accountID uuid.UUID, sum256 [32]byte) ([]byte, error) {
-	return nil, status.Errorf(codes.Unimplemented, "sql.itemRepo method GetData not implemented")
+func (r *itemRepo) PurgeTrash(ctx context.Context, accountID uuid.UUID) (int, error) {
+	w := &where{}
+	w.add("account_id = ?", accountID)
+	w.add("deleted_at IS NOT NULL")
+	return r.purge(ctx, w)
+}
+
+func (r *itemRepo) PurgeExpiredTrash(ctx context.Context, deletedBefore time.Time) (int, error) {
+	w := &where{}
+	w.add("deleted_at < ?", deletedBefore)
+	return r.purge(ctx, w)
+}
+
+// purge removes the items matching w with their revisions, and the item data
+// they referenced that is no longer referenced.
+func (r *itemRepo) purge(ctx context.Context, w *where) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	var revisionSums [][]byte
+	err = tx.SelectContext(ctx, &revisionSums, `
+		DELETE
+		FROM
+			item_revision
+		WHERE
+			item_id IN (SELECT id FROM item WHERE `+w.String()+`)
+		RETURNING
+			sum256;`,
+		w.args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	var sums [][]byte
+	err = tx.SelectContext(ctx, &sums, `
+		DELETE
+		FROM
+			item
+		WHERE
+			`+w.String()+`
+		RETURNING
+			sum256;`,
+		w.args...,
+	)
+	if err != nil {
+		return 0, err
+	}
+	_, _, err = r.deleteUnreferenced(ctx, tx, append(revisionSums, sums...))
+	if err != nil {
+		return 0, err
+	}
+	return len(sums), tx.Commit()
+}
+
+// notFoundIfNone returns a NotFound error if res affected no rows.
+func notFoundIfNone(res sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return status.Error(codes.NotFound, "item not found")
+	}
+	return nil
+}
+
+func (r *itemRepo) CollectGarbage(ctx context.Context, dryRun bool) (int, int64, error) {
+	if dryRun {
+		var v struct {
+			Count int   `db:"count"`
+			Size  int64 `db:"size"`
+		}
+		err := r.db.GetContext(ctx, &v, `
+			SELECT
+				COUNT(*) AS count, COALESCE(SUM(stored_size), 0) AS size
+			FROM
+				item_data
+			WHERE
+				`+unreferenced+`;`,
+		)
+		return v.Count, v.Size, err
+	}
+	return r.collectGarbage(ctx, gcBatchSize)
+}
+
+// collectGarbage deletes the unreferenced item data in transactions of
+// batchSize rows, and returns the number of rows and stored bytes deleted.
+func (r *itemRepo) collectGarbage(ctx context.Context, batchSize int) (int, int64, error) {
+	var (
+		count int
+		size  int64
+		after []byte
+	)
+	for {
+		n, s, last, err := r.collectGarbageBatch(ctx, batchSize, after)
+		if err != nil {
+			return count, size, err
+		}
+		count += n
+		size += s
+		if last == nil {
+			return count, size, nil
+		}
+		after = last
+	}
+}
+
+// unreferenced is the condition on item_data rows that no item or revision
+// references.
+const unreferenced = `NOT EXISTS (SELECT 1 FROM item WHERE item.sum256 = item_data.sum256)
+			AND NOT EXISTS (SELECT 1 FROM item_revision WHERE item_revision.sum256 = item_data.sum256)`
+
+// gcBatchSize is the number of unreferenced item data rows locked and
+// deleted per transaction.
+const gcBatchSize = 1000
+
+// collectGarbageBatch is a transaction of collectGarbage, deleting the
+// unreferenced rows after the given sum. Rows that are locked or referenced
+// again are skipped, they are left for the next collection. It returns the
+// sum of the last selected row, nil if it was the last batch.
+func (r *itemRepo) collectGarbageBatch(ctx context.Context, batchSize int, after []byte) (int, int64, []byte, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	defer tx.Rollback()
+	w := &where{}
+	w.add(unreferenced)
+	if after != nil {
+		w.add("sum256 > ?", after)
+	}
+	var sums [][]byte
+	err = tx.SelectContext(ctx, &sums, `
+		SELECT
+			sum256
+		FROM
+			item_data
+		WHERE
+			`+w.String()+`
+		ORDER BY
+			sum256
+		LIMIT
+			`+strconv.Itoa(batchSize)+`;`,
+		w.args...,
+	)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	n, size, err := r.deleteUnreferenced(ctx, tx, sums)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	var last []byte
+	if len(sums) == batchSize {
+		last = sums[len(sums)-1]
+	}
+	return n, size, last, tx.Commit()
+}
+
+// deleteUnreferenced deletes the item data with the given sums that no item
+// references, and returns the number of rows and stored bytes deleted. Rows locked by
+// insertData are skipped. The rows are locked first and checked again in a
+// new statement, which sees items committed in the meantime. Blobs are
+// deleted before tx commits, while inserting the same data waits for it.
+func (r *itemRepo) deleteUnreferenced(ctx context.Context, tx *sqlx.Tx, sums [][]byte) (int, int64, error) {
+	if len(sums) == 0 {
+		return 0, 0, nil
+	}
+	query, args, err := sqlx.In(`
+		SELECT
+			sum256
+		FROM
+			item_data
+		WHERE
+			sum256 IN (?)
+		`+lockRows(tx, "FOR UPDATE SKIP LOCKED")+`;`,
+		sums,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	var locked [][]byte
+	err = tx.SelectContext(ctx, &locked, tx.Rebind(query), args...)
+	if err != nil || len(locked) == 0 {
+		return 0, 0, err
+	}
+	query, args, err = sqlx.In(`
+		DELETE
+		FROM
+			item_data
+		WHERE
+			sum256 IN (?)
+			AND `+unreferenced+`
+		RETURNING
+			sum256, stored_size, external, codec;`,
+		locked,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	var deleted []struct {
+		Sum256     []byte `db:"sum256"`
+		StoredSize int64  `db:"stored_size"`
+		External   bool   `db:"external"`
+		Codec      string `db:"codec"`
+	}
+	err = tx.SelectContext(ctx, &deleted, tx.Rebind(query), args...)
+	if err != nil {
+		return 0, 0, err
+	}
+	var size int64
+	for _, d := range deleted {
+		size += d.StoredSize
+		if !d.External {
+			continue
+		}
+		if r.blobs == nil {
+			return 0, 0, errNoBlobStore
+		}
+		key := blob.Key{Codec: d.Codec}
+		copy(key.Sum256[:], d.Sum256)
+		err = r.blobs.Delete(ctx, key)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return len(deleted), size, nil
 }
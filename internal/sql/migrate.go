@@ -0,0 +1,252 @@
+package sql
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// migrationFS holds the migrations. Those of PostgreSQL up to version 9 were
+// applied by hand before, without a record of them, so applying them again is
+// a no-op.
+//
+//go:embed migrations
+var migrationFS embed.FS
+
+// Migration is a versioned change of the database schema, read from the
+// migrations directory of the database as <version>_<name>.up.sql and
+// <version>_<name>.down.sql.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus tells if and when a migration was applied.
+type MigrationStatus struct {
+	*Migration
+	AppliedAt *time.Time
+}
+
+// Migrations returns all migrations of the database of db, ordered by
+// version.
+func Migrations(db *sqlx.DB) ([]*Migration, error) {
+	dir := "migrations/postgres"
+	if isSQLite(db) {
+		dir = "migrations/sqlite"
+	}
+	files, err := fs.Glob(migrationFS, dir+"/*.sql")
+	if err != nil {
+		return nil, err
+	}
+	byVersion := make(map[int]*Migration)
+	for _, file := range files {
+		base := path.Base(file)
+		parts := strings.SplitN(base, "_", 2)
+		version, err := strconv.Atoi(parts[0])
+		if err != nil || len(parts) != 2 {
+			return nil, fmt.Errorf("malformed migration file name %q", base)
+		}
+		b, err := migrationFS.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version}
+			byVersion[version] = m
+		}
+		switch {
+		case strings.HasSuffix(parts[1], ".up.sql"):
+			m.Name = strings.TrimSuffix(parts[1], ".up.sql")
+			m.Up = string(b)
+		case strings.HasSuffix(parts[1], ".down.sql"):
+			m.Down = string(b)
+		default:
+			return nil, fmt.Errorf("malformed migration file name %q", base)
+		}
+	}
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d lacks an up or down file", m.Version)
+		}
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// MigrateUp applies the pending migrations up to and including version, or
+// all of them if version is zero, and returns the applied ones. Each
+// migration runs in its own transaction.
+func MigrateUp(ctx context.Context, db *sqlx.DB, version int) ([]*Migration, error) {
+	migrations, err := Migrations(db)
+	if err != nil {
+		return nil, err
+	}
+	var applied []*Migration
+	for _, m := range migrations {
+		if version != 0 && m.Version > version {
+			break
+		}
+		ok, err := migrate(ctx, db, m, true)
+		if err != nil {
+			return applied, fmt.Errorf("migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if ok {
+			applied = append(applied, m)
+		}
+	}
+	return applied, nil
+}
+
+// MigrateDown reverts the latest steps applied migrations, and returns the
+// reverted ones.
+func MigrateDown(ctx context.Context, db *sqlx.DB, steps int) ([]*Migration, error) {
+	status, err := Status(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	var reverted []*Migration
+	for i := len(status) - 1; i >= 0 && len(reverted) < steps; i-- {
+		m := status[i]
+		if m.AppliedAt == nil {
+			continue
+		}
+		ok, err := migrate(ctx, db, m.Migration, false)
+		if err != nil {
+			return reverted, fmt.Errorf("migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if ok {
+			reverted = append(reverted, m.Migration)
+		}
+	}
+	return reverted, nil
+}
+
+// Status returns all migrations, and when they were applied.
+func Status(ctx context.Context, db *sqlx.DB) ([]*MigrationStatus, error) {
+	migrations, err := Migrations(db)
+	if err != nil {
+		return nil, err
+	}
+	err = createMigrationTable(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	var v []struct {
+		Version   int       `db:"version"`
+		AppliedAt time.Time `db:"applied_at"`
+	}
+	err = db.SelectContext(ctx, &v, `
+		SELECT
+			version, applied_at
+		FROM
+			schema_migration;`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	appliedAt := make(map[int]time.Time)
+	for _, m := range v {
+		appliedAt[m.Version] = m.AppliedAt
+	}
+	status := make([]*MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		status[i] = &MigrationStatus{Migration: m}
+		if t, ok := appliedAt[m.Version]; ok {
+			status[i].AppliedAt = &t
+		}
+	}
+	return status, nil
+}
+
+func createMigrationTable(ctx context.Context, db *sqlx.DB) error {
+	timestamp := "timestamptz"
+	if isSQLite(db) {
+		timestamp = "timestamp"
+	}
+	_, err := db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migration (
+			version bigint PRIMARY KEY,
+			applied_at `+timestamp+` NOT NULL
+		);`,
+	)
+	return err
+}
+
+// migrate applies, or reverts, m unless it already is. The migration table
+// is locked, so concurrent servers migrating on start apply m once. SQLite
+// transactions lock the whole database already.
+func migrate(ctx context.Context, db *sqlx.DB, m *Migration, up bool) (bool, error) {
+	err := createMigrationTable(ctx, db)
+	if err != nil {
+		return false, err
+	}
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+	if !isSQLite(tx) {
+		_, err = tx.ExecContext(ctx, `LOCK TABLE schema_migration IN EXCLUSIVE MODE;`)
+		if err != nil {
+			return false, err
+		}
+	}
+	var applied bool
+	err = tx.GetContext(ctx, &applied, `
+		SELECT
+			EXISTS (SELECT 1 FROM schema_migration WHERE version = $1);`,
+		m.Version,
+	)
+	if err != nil {
+		return false, err
+	}
+	if applied == up {
+		return false, nil
+	}
+	if up {
+		_, err = tx.ExecContext(ctx, m.Up)
+		if err != nil {
+			return false, err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT
+			INTO
+				schema_migration (version, applied_at)
+			VALUES
+				($1, $2);`,
+			m.Version, time.Now(),
+		)
+	} else {
+		_, err = tx.ExecContext(ctx, m.Down)
+		if err != nil {
+			return false, err
+		}
+		_, err = tx.ExecContext(ctx, `
+			DELETE
+			FROM
+				schema_migration
+			WHERE
+				version = $1;`,
+			m.Version,
+		)
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
@@ -0,0 +1,7 @@
+-- The tables predate migrations and hold every account and item, they are
+-- never dropped.
+DO $$
+BEGIN
+    RAISE EXCEPTION 'the initial schema can''t be reverted';
+END
+$$;
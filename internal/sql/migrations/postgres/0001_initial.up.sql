@@ -0,0 +1,20 @@
+-- The schema predating migrations, so existing databases can adopt them.
+CREATE TABLE IF NOT EXISTS account (
+    id uuid PRIMARY KEY,
+    hashed_password bytea,
+    nickname text NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS item_data (
+    sum256 bytea GENERATED ALWAYS AS (sha256(item_data)) STORED PRIMARY KEY,
+    item_data bytea NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS item (
+    id bytea PRIMARY KEY,
+    sum256 bytea NOT NULL REFERENCES item_data (sum256),
+    account_id uuid NOT NULL REFERENCES account (id)
+);
+
+CREATE INDEX IF NOT EXISTS item_account_id_idx ON item (account_id, id);
+CREATE INDEX IF NOT EXISTS item_sum256_idx ON item (sum256);
@@ -0,0 +1,2 @@
+ALTER TABLE item_data
+    ADD COLUMN IF NOT EXISTS kind text;
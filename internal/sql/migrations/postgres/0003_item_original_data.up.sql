@@ -0,0 +1,4 @@
+-- original_data is the imported JSON, if it differs from the canonical
+-- item_data and keeping it was requested.
+ALTER TABLE item
+    ADD COLUMN IF NOT EXISTS original_data bytea;
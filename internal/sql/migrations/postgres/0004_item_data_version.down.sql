@@ -0,0 +1,2 @@
+ALTER TABLE item_data
+    DROP COLUMN version;
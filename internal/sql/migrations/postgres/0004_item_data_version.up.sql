@@ -0,0 +1,4 @@
+ALTER TABLE item_data
+    ADD COLUMN IF NOT EXISTS version bigint;
+
+CREATE INDEX IF NOT EXISTS item_data_version_idx ON item_data (version);
@@ -0,0 +1,4 @@
+ALTER TABLE item
+    ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS item_deleted_at_idx ON item (deleted_at) WHERE deleted_at IS NOT NULL;
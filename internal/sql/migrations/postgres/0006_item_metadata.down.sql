@@ -0,0 +1,5 @@
+DROP TABLE item_tag;
+
+ALTER TABLE item
+    DROP COLUMN description,
+    DROP COLUMN title;
@@ -0,0 +1,11 @@
+ALTER TABLE item
+    ADD COLUMN IF NOT EXISTS title text,
+    ADD COLUMN IF NOT EXISTS description text;
+
+CREATE TABLE IF NOT EXISTS item_tag (
+    item_id bytea NOT NULL REFERENCES item (id) ON DELETE CASCADE,
+    tag text NOT NULL,
+    PRIMARY KEY (item_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS item_tag_tag_idx ON item_tag (tag);
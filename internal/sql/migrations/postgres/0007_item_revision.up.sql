@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS item_revision (
+    item_id bytea NOT NULL REFERENCES item (id) ON DELETE CASCADE,
+    revision bigint NOT NULL,
+    sum256 bytea NOT NULL REFERENCES item_data (sum256),
+    original_data bytea,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (item_id, revision)
+);
+
+CREATE INDEX IF NOT EXISTS item_revision_sum256_idx ON item_revision (sum256);
+
+-- Existing items become their first revision, made when the item was, which
+-- is the time in the first 48 bits of their ULID.
+INSERT INTO item_revision (item_id, revision, sum256, original_data, created_at)
+SELECT
+    id, 1, sum256, original_data,
+    to_timestamp(('x' || encode(substring(id FROM 1 FOR 6), 'hex'))::bit(48)::bigint / 1000.0)
+FROM
+    item
+ON CONFLICT
+DO
+    NOTHING;
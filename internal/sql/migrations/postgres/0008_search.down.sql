@@ -0,0 +1,5 @@
+DROP TABLE item_data_page;
+
+ALTER TABLE item_data
+    DROP COLUMN search_vector,
+    DROP COLUMN search_text;
@@ -0,0 +1,15 @@
+-- Item data stored before this migration is indexed by "fabl reindex".
+ALTER TABLE item_data
+    ADD COLUMN IF NOT EXISTS search_text text,
+    ADD COLUMN IF NOT EXISTS search_vector tsvector
+        GENERATED ALWAYS AS (to_tsvector('english', COALESCE(search_text, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS item_data_search_vector_idx ON item_data USING gin (search_vector);
+
+CREATE TABLE IF NOT EXISTS item_data_page (
+    sum256 bytea NOT NULL REFERENCES item_data (sum256) ON DELETE CASCADE,
+    path text NOT NULL,
+    label text NOT NULL,
+    search_text text NOT NULL,
+    PRIMARY KEY (sum256, path)
+);
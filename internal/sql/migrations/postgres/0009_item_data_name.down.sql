@@ -0,0 +1,4 @@
+DROP TABLE item_data_name;
+
+ALTER TABLE item_data
+    DROP COLUMN modded;
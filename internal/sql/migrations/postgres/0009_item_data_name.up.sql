@@ -0,0 +1,15 @@
+ALTER TABLE item_data
+    ADD COLUMN IF NOT EXISTS modded boolean;
+
+CREATE TABLE IF NOT EXISTS item_data_name (
+    sum256 bytea NOT NULL REFERENCES item_data (sum256) ON DELETE CASCADE,
+    kind text NOT NULL,
+    name text NOT NULL,
+    PRIMARY KEY (sum256, kind, name)
+);
+
+CREATE INDEX IF NOT EXISTS item_data_name_name_idx ON item_data_name (kind, name);
+
+-- Item data indexed before this migration lacks the names it is filtered by,
+-- it is indexed again by "fabl reindex".
+UPDATE item_data SET search_text = NULL WHERE modded IS NULL;
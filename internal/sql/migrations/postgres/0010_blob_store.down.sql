@@ -0,0 +1,23 @@
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM item_data WHERE external) THEN
+        RAISE EXCEPTION 'item data is kept in the blob store, move it back with fabl blobs migrate --to-database first';
+    END IF;
+END
+$$;
+
+ALTER TABLE item_data
+    DROP COLUMN external,
+    DROP COLUMN size;
+
+-- A generated column can't be restored in place, compute sum256 on insert
+-- instead.
+CREATE FUNCTION item_data_sum256() RETURNS trigger AS $$
+BEGIN
+    NEW.sum256 := sha256(NEW.item_data);
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER item_data_sum256 BEFORE INSERT ON item_data
+    FOR EACH ROW EXECUTE FUNCTION item_data_sum256();
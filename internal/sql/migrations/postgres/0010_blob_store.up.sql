@@ -0,0 +1,13 @@
+-- The application computes sum256, as item data kept in the blob store
+-- leaves item_data empty.
+DROP TRIGGER IF EXISTS item_data_sum256 ON item_data;
+DROP FUNCTION IF EXISTS item_data_sum256();
+ALTER TABLE item_data ALTER COLUMN sum256 DROP EXPRESSION IF EXISTS;
+
+-- external is set when the data is kept in the blob store instead of
+-- item_data, which is then empty. size is the length of the data either way.
+ALTER TABLE item_data
+    ADD COLUMN external boolean NOT NULL DEFAULT FALSE,
+    ADD COLUMN size bigint NOT NULL DEFAULT 0;
+
+UPDATE item_data SET size = octet_length(item_data);
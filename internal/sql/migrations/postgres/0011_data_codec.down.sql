@@ -0,0 +1,11 @@
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM item_data WHERE codec <> '') THEN
+        RAISE EXCEPTION 'item data is encoded, decode it with fabl blobs compress --decode first';
+    END IF;
+END
+$$;
+
+ALTER TABLE item_data
+    DROP COLUMN codec,
+    DROP COLUMN stored_size;
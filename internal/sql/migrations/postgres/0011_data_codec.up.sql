@@ -0,0 +1,7 @@
+-- codec is how the stored data is encoded, empty if not encoded, and
+-- stored_size is its length as stored, in item_data or the blob store.
+ALTER TABLE item_data
+    ADD COLUMN codec text NOT NULL DEFAULT '',
+    ADD COLUMN stored_size bigint NOT NULL DEFAULT 0;
+
+UPDATE item_data SET stored_size = size;
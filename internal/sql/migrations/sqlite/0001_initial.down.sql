@@ -0,0 +1,7 @@
+DROP TABLE item_data_name;
+DROP TABLE item_data_page;
+DROP TABLE item_revision;
+DROP TABLE item_tag;
+DROP TABLE item;
+DROP TABLE item_data;
+DROP TABLE account;
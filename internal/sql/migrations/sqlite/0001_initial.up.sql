@@ -0,0 +1,68 @@
+CREATE TABLE account (
+    id text PRIMARY KEY,
+    hashed_password blob,
+    nickname text NOT NULL
+);
+
+-- sum256 is the SHA-256 of item_data, computed by the application as SQLite
+-- lacks a sha256 function.
+CREATE TABLE item_data (
+    sum256 blob PRIMARY KEY,
+    item_data blob NOT NULL,
+    kind text,
+    version integer,
+    search_text text,
+    modded boolean
+);
+
+CREATE INDEX item_data_version_idx ON item_data (version);
+
+CREATE TABLE item (
+    id blob PRIMARY KEY,
+    sum256 blob NOT NULL REFERENCES item_data (sum256),
+    account_id text NOT NULL REFERENCES account (id),
+    original_data blob,
+    deleted_at timestamp,
+    title text,
+    description text
+);
+
+CREATE INDEX item_account_id_idx ON item (account_id, id);
+CREATE INDEX item_sum256_idx ON item (sum256);
+CREATE INDEX item_deleted_at_idx ON item (deleted_at) WHERE deleted_at IS NOT NULL;
+
+CREATE TABLE item_tag (
+    item_id blob NOT NULL REFERENCES item (id) ON DELETE CASCADE,
+    tag text NOT NULL,
+    PRIMARY KEY (item_id, tag)
+);
+
+CREATE INDEX item_tag_tag_idx ON item_tag (tag);
+
+CREATE TABLE item_revision (
+    item_id blob NOT NULL REFERENCES item (id) ON DELETE CASCADE,
+    revision integer NOT NULL,
+    sum256 blob NOT NULL REFERENCES item_data (sum256),
+    original_data blob,
+    created_at timestamp NOT NULL,
+    PRIMARY KEY (item_id, revision)
+);
+
+CREATE INDEX item_revision_sum256_idx ON item_revision (sum256);
+
+CREATE TABLE item_data_page (
+    sum256 blob NOT NULL REFERENCES item_data (sum256) ON DELETE CASCADE,
+    path text NOT NULL,
+    label text NOT NULL,
+    search_text text NOT NULL,
+    PRIMARY KEY (sum256, path)
+);
+
+CREATE TABLE item_data_name (
+    sum256 blob NOT NULL REFERENCES item_data (sum256) ON DELETE CASCADE,
+    kind text NOT NULL,
+    name text NOT NULL,
+    PRIMARY KEY (sum256, kind, name)
+);
+
+CREATE INDEX item_data_name_name_idx ON item_data_name (kind, name);
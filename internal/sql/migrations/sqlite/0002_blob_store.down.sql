@@ -0,0 +1,8 @@
+-- Fails while item data is kept in the blob store, move it back with fabl
+-- blobs migrate --to-database first.
+CREATE TEMP TABLE blob_store_check (moved_to_database boolean CHECK (moved_to_database));
+INSERT INTO blob_store_check SELECT FALSE FROM item_data WHERE external LIMIT 1;
+DROP TABLE blob_store_check;
+
+ALTER TABLE item_data DROP COLUMN external;
+ALTER TABLE item_data DROP COLUMN size;
@@ -0,0 +1,6 @@
+-- external is set when the data is kept in the blob store instead of
+-- item_data, which is then empty. size is the length of the data either way.
+ALTER TABLE item_data ADD COLUMN external boolean NOT NULL DEFAULT FALSE;
+ALTER TABLE item_data ADD COLUMN size integer NOT NULL DEFAULT 0;
+
+UPDATE item_data SET size = length(item_data);
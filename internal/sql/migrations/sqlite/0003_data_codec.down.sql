@@ -0,0 +1,8 @@
+-- Fails while item data is encoded, decode it with fabl blobs compress
+-- --decode first.
+CREATE TEMP TABLE data_codec_check (decoded boolean CHECK (decoded));
+INSERT INTO data_codec_check SELECT FALSE FROM item_data WHERE codec <> '' LIMIT 1;
+DROP TABLE data_codec_check;
+
+ALTER TABLE item_data DROP COLUMN codec;
+ALTER TABLE item_data DROP COLUMN stored_size;
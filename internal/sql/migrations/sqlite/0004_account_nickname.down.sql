@@ -0,0 +1 @@
+DROP INDEX account_nickname_idx;
@@ -0,0 +1,3 @@
+-- Fails if nicknames of existing accounts only differ by case, rename them
+-- first.
+CREATE UNIQUE INDEX account_nickname_idx ON account (lower(nickname));
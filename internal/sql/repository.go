@@ -1,6 +1,7 @@
 package sql
 
 import (
+	"api.fabl.app/internal/blob"
 	"api.fabl.app/internal/repository"
 	"github.com/jmoiron/sqlx"
 )
@@ -10,9 +11,11 @@ type Repository struct {
 	Item    repository.ItemRepository
 }
 
-func NewRepository(db *sqlx.DB) *Repository {
+// NewRepository returns the repositories of db. Item data is inserted in
+// blobs if not nil, and in db otherwise.
+func NewRepository(db *sqlx.DB, blobs blob.Store) *Repository {
 	return &Repository{
-		Item:    &itemRepo{db: db},
+		Item:    &itemRepo{db: db, blobs: blobs},
 		Account: &accountRepo{db: db},
 	}
 }
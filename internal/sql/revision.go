@@ -0,0 +1,237 @@
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"api.fabl.app/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/oklog/ulid/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (r *itemRepo) AddRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, item *repository.Item) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	current, err := lockItem(ctx, tx, accountID, id)
+	if err != nil {
+		return err
+	}
+	err = r.insertData(ctx, tx, item)
+	if err != nil {
+		return err
+	}
+	item.ULID = id
+	if *item.Sum256 == current.Sum256 {
+		item.Revision = current.Revision
+		return tx.Commit()
+	}
+	if item.TimeMs == 0 {
+		item.TimeMs = ulid.Now()
+	}
+	item.Revision = current.Revision + 1
+	err = insertRevision(ctx, tx, item)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *itemRepo) ListRevisions(ctx context.Context, accountID uuid.UUID, id ulid.ULID) ([]*repository.Item, error) {
+	var v []*struct {
+		Revision  int64     `db:"revision"`
+		CreatedAt time.Time `db:"created_at"`
+		Sum256    []byte    `db:"sum256"`
+		Kind      string    `db:"kind"`
+		Version   int64     `db:"version"`
+	}
+	err := r.db.SelectContext(ctx, &v, `
+		SELECT
+			revision, created_at, item_revision.sum256, COALESCE(kind, '') AS kind,
+			COALESCE(version, 0) AS version
+		FROM
+			item_revision
+			INNER JOIN item ON item_revision.item_id = item.id
+			INNER JOIN item_data ON item_revision.sum256 = item_data.sum256
+		WHERE
+			account_id = $1 AND item_id = $2 AND deleted_at IS NULL
+		ORDER BY
+			revision;`,
+		accountID, id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(v) == 0 {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	items := make([]*repository.Item, len(v))
+	for i, rev := range v {
+		items[i] = &repository.Item{
+			ULID:     id,
+			TimeMs:   uint64(rev.CreatedAt.UnixNano() / int64(time.Millisecond)),
+			Sum256:   new([32]byte),
+			Kind:     rev.Kind,
+			Version:  uint64(rev.Version),
+			Revision: uint64(rev.Revision),
+		}
+		copy(items[i].Sum256[:], rev.Sum256)
+	}
+	return items, nil
+}
+
+func (r *itemRepo) GetRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, revision uint64) (*repository.Item, error) {
+	var v struct {
+		CreatedAt time.Time `db:"created_at"`
+		Sum256    []byte    `db:"sum256"`
+		Kind      string    `db:"kind"`
+		Version   int64     `db:"version"`
+		Original  []byte    `db:"original_data"`
+		storedData
+	}
+	err := r.db.GetContext(ctx, &v, `
+		SELECT
+			created_at, item_data, external, codec, item_revision.sum256, COALESCE(kind, '') AS kind,
+			COALESCE(version, 0) AS version, item_revision.original_data
+		FROM
+			item_revision
+			INNER JOIN item ON item_revision.item_id = item.id
+			INNER JOIN item_data ON item_revision.sum256 = item_data.sum256
+		WHERE
+			account_id = $1 AND item_id = $2 AND revision = $3 AND deleted_at IS NULL;`,
+		accountID, id, int64(revision),
+	)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := r.loadData(ctx, v.Sum256, &v.storedData)
+	if err != nil {
+		return nil, err
+	}
+	item := &repository.Item{
+		ULID:     id,
+		TimeMs:   uint64(v.CreatedAt.UnixNano() / int64(time.Millisecond)),
+		Data:     data,
+		Sum256:   new([32]byte),
+		Kind:     v.Kind,
+		Version:  uint64(v.Version),
+		Original: v.Original,
+		Revision: revision,
+	}
+	copy(item.Sum256[:], v.Sum256)
+	return item, nil
+}
+
+func (r *itemRepo) RevertToRevision(ctx context.Context, accountID uuid.UUID, id ulid.ULID, revision uint64) (uint64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	current, err := lockItem(ctx, tx, accountID, id)
+	if err != nil {
+		return 0, err
+	}
+	var v struct {
+		Sum256   []byte `db:"sum256"`
+		Original []byte `db:"original_data"`
+	}
+	err = tx.GetContext(ctx, &v, `
+		SELECT
+			sum256, original_data
+		FROM
+			item_revision
+		WHERE
+			item_id = $1 AND revision = $2;`,
+		id, int64(revision),
+	)
+	if err == sql.ErrNoRows {
+		return 0, status.Error(codes.NotFound, "revision not found")
+	}
+	if err != nil {
+		return 0, err
+	}
+	item := &repository.Item{
+		ULID:     id,
+		TimeMs:   ulid.Now(),
+		Sum256:   new([32]byte),
+		Original: v.Original,
+		Revision: current.Revision + 1,
+	}
+	copy(item.Sum256[:], v.Sum256)
+	err = insertRevision(ctx, tx, item)
+	if err != nil {
+		return 0, err
+	}
+	return item.Revision, tx.Commit()
+}
+
+// currentRevision identifies the current content of an item.
+type currentRevision struct {
+	Sum256   [32]byte
+	Revision uint64
+}
+
+// lockItem locks an item of the account for adding a revision, and returns
+// its current revision.
+func lockItem(ctx context.Context, tx *sqlx.Tx, accountID uuid.UUID, id ulid.ULID) (*currentRevision, error) {
+	var v struct {
+		Sum256   []byte `db:"sum256"`
+		Revision int64  `db:"revision"`
+	}
+	err := tx.GetContext(ctx, &v, `
+		SELECT
+			sum256, (SELECT COALESCE(MAX(revision), 1) FROM item_revision WHERE item_id = item.id) AS revision
+		FROM
+			item
+		WHERE
+			account_id = $1 AND id = $2 AND deleted_at IS NULL
+		`+lockRows(tx, "FOR UPDATE")+`;`,
+		accountID, id,
+	)
+	if err == sql.ErrNoRows {
+		return nil, status.Error(codes.NotFound, "item not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	current := &currentRevision{Revision: uint64(v.Revision)}
+	copy(current.Sum256[:], v.Sum256)
+	return current, nil
+}
+
+// insertRevision records item as revision item.Revision of the item
+// item.ULID, and makes it the current content of that item.
+func insertRevision(ctx context.Context, tx *sqlx.Tx, item *repository.Item) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT
+		INTO
+			item_revision (item_id, revision, sum256, original_data, created_at)
+		VALUES
+			($1, $2, $3, $4, $5);`,
+		item.ULID[:], int64(item.Revision), item.Sum256[:], item.Original,
+		time.Unix(0, int64(item.TimeMs)*int64(time.Millisecond)),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `
+		UPDATE
+			item
+		SET
+			sum256 = $1, original_data = $2
+		WHERE
+			id = $3;`,
+		item.Sum256[:], item.Original, item.ULID[:],
+	)
+	return err
+}
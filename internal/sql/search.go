@@ -0,0 +1,385 @@
+package sql
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"api.fabl.app/internal/blob"
+	"api.fabl.app/internal/repository"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/oklog/ulid/v2"
+)
+
+// searchDocument is the text search document of an item joined with its
+// item_data. The title and tags weigh most, followed by the description and
+// then the indexed data.
+const searchDocument = `(
+			setweight(to_tsvector('english', COALESCE(title, '') || ' ' || COALESCE(
+				(SELECT string_agg(tag, ' ') FROM item_tag WHERE item_id = item.id), ''
+			)), 'A')
+			|| setweight(to_tsvector('english', COALESCE(description, '')), 'B')
+			|| COALESCE(search_vector, '')
+		)`
+
+func (r *itemRepo) Search(ctx context.Context, accountID uuid.UUID, query string, opts repository.SearchOptions) ([]*repository.SearchResult, int, error) {
+	w := &where{}
+	w.add("account_id = ?", accountID)
+	w.add("deleted_at IS NULL")
+	var rank string
+	if isSQLite(r.db) {
+		rank = addWordMatch(w, query)
+	} else {
+		w.add(searchDocument+" @@ websearch_to_tsquery('english', ?)", query)
+		rank = "ts_rank(" + searchDocument + ", websearch_to_tsquery('english', $" + strconv.Itoa(len(w.args)) + "))"
+	}
+	addContentFilter(w, opts.Content)
+
+	var total int
+	err := r.db.GetContext(ctx, &total, `
+		SELECT
+			COUNT(*)
+		FROM
+			item
+			INNER JOIN item_data ON item.sum256 = item_data.sum256
+		WHERE
+			`+w.String()+`;`,
+		w.args...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = math.MaxInt32
+	}
+	var v []*struct {
+		ULID    ulid.ULID `db:"id"`
+		Sum256  []byte    `db:"sum256"`
+		Kind    string    `db:"kind"`
+		Version int64     `db:"version"`
+		Rank    float32   `db:"rank"`
+		metadata
+	}
+	err = r.db.SelectContext(ctx, &v, `
+		SELECT
+			id, item.sum256, COALESCE(kind, '') AS kind, COALESCE(version, 0) AS version,
+			COALESCE(title, '') AS title, COALESCE(description, '') AS description,
+			`+rank+` AS rank
+		FROM
+			item
+			INNER JOIN item_data ON item.sum256 = item_data.sum256
+		WHERE
+			`+w.String()+`
+		ORDER BY
+			rank DESC, id DESC
+		LIMIT
+			`+strconv.Itoa(limit)+`
+		OFFSET
+			`+strconv.Itoa(opts.Offset)+`;`,
+		w.args...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]ulid.ULID, len(v))
+	sums := make([][]byte, len(v))
+	for i, item := range v {
+		ids[i] = item.ULID
+		sums[i] = item.Sum256
+	}
+	tags, err := itemTags(ctx, r.db, ids...)
+	if err != nil {
+		return nil, 0, err
+	}
+	pages, err := r.searchPages(ctx, query, sums)
+	if err != nil {
+		return nil, 0, err
+	}
+	results := make([]*repository.SearchResult, len(v))
+	for i, item := range v {
+		results[i] = &repository.SearchResult{
+			Item: &repository.Item{
+				ULID:     item.ULID,
+				TimeMs:   item.ULID.Time(),
+				Sum256:   new([32]byte),
+				Kind:     item.Kind,
+				Version:  uint64(item.Version),
+				Metadata: item.withTags(tags[item.ULID]),
+			},
+			Rank:  item.Rank,
+			Pages: pages[string(item.Sum256)],
+		}
+		copy(results[i].Item.Sum256[:], item.Sum256)
+	}
+	return results, total, nil
+}
+
+// addContentFilter adds the conditions of f on the item_data joined in the
+// query to w.
+func addContentFilter(w *where, f repository.ContentFilter) {
+	const hasName = `EXISTS (
+				SELECT 1 FROM item_data_name
+				WHERE item_data_name.sum256 = item_data.sum256 AND item_data_name.kind = ? AND name = ?
+			)`
+	for _, name := range f.Entities {
+		w.add(hasName, nameEntity, name)
+	}
+	for _, name := range f.Recipes {
+		w.add(hasName, nameRecipe, name)
+	}
+	for _, name := range f.ExcludedEntities {
+		w.add("NOT "+hasName, nameEntity, name)
+	}
+	if f.NoModded {
+		w.add("modded = FALSE")
+	}
+}
+
+// Kinds of the names in item_data_name.
+const (
+	nameEntity = "entity"
+	nameRecipe = "recipe"
+)
+
+// searchPages returns the book pages of the given item data matching query,
+// by sum256.
+func (r *itemRepo) searchPages(ctx context.Context, query string, sums [][]byte) (map[string][]repository.PageMatch, error) {
+	if len(sums) == 0 {
+		return nil, nil
+	}
+	if isSQLite(r.db) {
+		return r.matchPages(ctx, query, sums)
+	}
+	var v []struct {
+		Sum256   []byte `db:"sum256"`
+		Path     string `db:"path"`
+		Label    string `db:"label"`
+		Headline string `db:"headline"`
+	}
+	err := r.db.SelectContext(ctx, &v, `
+		SELECT
+			sum256, path, label,
+			ts_headline('english', label, query, 'HighlightAll=true') AS headline
+		FROM
+			item_data_page,
+			websearch_to_tsquery('english', $1) AS query
+		WHERE
+			sum256 = ANY($2)
+			AND to_tsvector('english', label || ' ' || search_text) @@ query;`,
+		query, pq.ByteaArray(sums),
+	)
+	if err != nil {
+		return nil, err
+	}
+	pages := make(map[string][]repository.PageMatch)
+	for _, p := range v {
+		path, err := parsePath(p.Path)
+		if err != nil {
+			return nil, err
+		}
+		pages[string(p.Sum256)] = append(pages[string(p.Sum256)], repository.PageMatch{
+			Path:     path,
+			Label:    p.Label,
+			Headline: p.Headline,
+		})
+	}
+	sortPages(pages)
+	return pages, nil
+}
+
+func sortPages(pages map[string][]repository.PageMatch) {
+	for _, matches := range pages {
+		sort.Slice(matches, func(i, j int) bool {
+			return pathLess(matches[i].Path, matches[j].Path)
+		})
+	}
+}
+
+// wordDocument is the lower case text an item joined with its item_data is
+// searched in on SQLite, which lacks full-text search by default.
+const wordDocument = `lower(
+			COALESCE(title, '') || ' ' || COALESCE(description, '') || ' ' || COALESCE(
+				(SELECT group_concat(tag, ' ') FROM item_tag WHERE item_id = item.id), ''
+			) || ' ' || COALESCE(search_text, '')
+		)`
+
+// addWordMatch adds conditions to w matching the items containing every word
+// of query, and none of its words prefixed by -. It returns an expression
+// ranking the matches, weighing words like searchDocument does.
+func addWordMatch(w *where, query string) string {
+	include, exclude := repository.SearchWords(query)
+	rank := []string{"0"}
+	for _, word := range include {
+		w.add("instr("+wordDocument+", ?) > 0", word)
+		p := "$" + strconv.Itoa(len(w.args))
+		rank = append(rank, `(instr(lower(COALESCE(title, '') || ' ' || COALESCE(
+				(SELECT group_concat(tag, ' ') FROM item_tag WHERE item_id = item.id), ''
+			)), `+p+`) > 0) * 1.0
+			+ (instr(lower(COALESCE(description, '')), `+p+`) > 0) * 0.4
+			+ (instr(lower(COALESCE(search_text, '')), `+p+`) > 0) * 0.1`)
+	}
+	for _, word := range exclude {
+		w.add("instr("+wordDocument+", ?) = 0", word)
+	}
+	return "(" + strings.Join(rank, " + ") + ")"
+}
+
+// matchPages is searchPages on SQLite.
+func (r *itemRepo) matchPages(ctx context.Context, query string, sums [][]byte) (map[string][]repository.PageMatch, error) {
+	q, args, err := sqlx.In(`
+		SELECT
+			sum256, path, label, search_text
+		FROM
+			item_data_page
+		WHERE
+			sum256 IN (?);`,
+		sums,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var v []struct {
+		Sum256     []byte `db:"sum256"`
+		Path       string `db:"path"`
+		Label      string `db:"label"`
+		SearchText string `db:"search_text"`
+	}
+	err = r.db.SelectContext(ctx, &v, r.db.Rebind(q), args...)
+	if err != nil {
+		return nil, err
+	}
+	include, exclude := repository.SearchWords(query)
+	pages := make(map[string][]repository.PageMatch)
+	for _, p := range v {
+		if !repository.MatchWords(p.Label+" "+p.SearchText, include, exclude) {
+			continue
+		}
+		path, err := parsePath(p.Path)
+		if err != nil {
+			return nil, err
+		}
+		pages[string(p.Sum256)] = append(pages[string(p.Sum256)], repository.PageMatch{
+			Path:     path,
+			Label:    p.Label,
+			Headline: repository.Highlight(p.Label, include),
+		})
+	}
+	sortPages(pages)
+	return pages, nil
+}
+
+// IndexData indexes the item data stored before search was, in transactions
+// of batchSize rows, and returns the number of indexed blobs. index returns
+// the search index of item data.
+func IndexData(ctx context.Context, db *sqlx.DB, blobs blob.Store, batchSize int, index func(data []byte) *repository.SearchIndex) (int, error) {
+	r := &itemRepo{db: db, blobs: blobs}
+	w := &where{}
+	w.add("search_text IS NULL")
+	return eachData(ctx, db, batchSize, w, func(tx *sqlx.Tx, sum [32]byte, row *storedData) error {
+		data, err := r.loadData(ctx, sum[:], row)
+		if err != nil {
+			return err
+		}
+		return indexData(ctx, tx, sum[:], index(data))
+	}, nil)
+}
+
+// indexData stores the search index of item data, and the names it is
+// filtered by, unless it is indexed already.
+func indexData(ctx context.Context, tx *sqlx.Tx, sum256 []byte, index *repository.SearchIndex) error {
+	res, err := tx.ExecContext(ctx, `
+		UPDATE
+			item_data
+		SET
+			search_text = $1, modded = $2
+		WHERE
+			sum256 = $3 AND search_text IS NULL;`,
+		index.Text, index.Modded, sum256,
+	)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil || n == 0 {
+		return err
+	}
+	for _, p := range index.Pages {
+		_, err = tx.ExecContext(ctx, `
+			INSERT
+			INTO
+				item_data_page (sum256, path, label, search_text)
+			VALUES
+				($1, $2, $3, $4)
+			ON CONFLICT
+			DO
+				NOTHING;`,
+			sum256, formatPath(p.Path), p.Label, p.Text,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	names := map[string][]string{
+		nameEntity: index.Entities,
+		nameRecipe: index.Recipes,
+	}
+	for kind, names := range names {
+		for _, name := range names {
+			_, err = tx.ExecContext(ctx, `
+				INSERT
+				INTO
+					item_data_name (sum256, kind, name)
+				VALUES
+					($1, $2, $3)
+				ON CONFLICT
+				DO
+					NOTHING;`,
+				sum256, kind, name,
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// formatPath formats the path of a book page as its entry indices separated
+// by dots.
+func formatPath(path []uint64) string {
+	s := make([]string, len(path))
+	for i, index := range path {
+		s[i] = strconv.FormatUint(index, 10)
+	}
+	return strings.Join(s, ".")
+}
+
+func parsePath(s string) ([]uint64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ".")
+	path := make([]uint64, len(parts))
+	for i, part := range parts {
+		index, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		path[i] = index
+	}
+	return path, nil
+}
+
+func pathLess(a, b []uint64) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
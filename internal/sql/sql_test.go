@@ -0,0 +1,207 @@
+package sql
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"testing"
+
+	"api.fabl.app/internal/blob"
+	"api.fabl.app/internal/repository"
+	"api.fabl.app/internal/repository/repositorytest"
+	"github.com/google/uuid"
+)
+
+// TestRepository runs the conformance tests on an in-memory SQLite database,
+// and on the PostgreSQL database FABL_TEST_POSTGRES_DSN names, if set. That
+// database is migrated up and test data is left in it. Both are tested with
+// item data kept in the database and in a blob store.
+func TestRepository(t *testing.T) {
+	dsns := map[string]string{"SQLite": "sqlite::memory:"}
+	if dsn := os.Getenv("FABL_TEST_POSTGRES_DSN"); dsn != "" {
+		dsns["PostgreSQL"] = dsn
+	}
+	for name, dsn := range dsns {
+		for _, withBlobs := range []bool{false, true} {
+			name := name
+			if withBlobs {
+				name += "/BlobStore"
+			}
+			t.Run(name, func(t *testing.T) {
+				testRepository(t, dsn, withBlobs)
+			})
+		}
+	}
+}
+
+func testRepository(t *testing.T, dsn string, withBlobs bool) {
+	ctx := context.Background()
+	db, err := Connect(dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	_, err = MigrateUp(ctx, db, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var blobs blob.Store
+	if withBlobs {
+		blobs, err = blob.NewFileStore(t.TempDir())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	repo := NewRepository(db, blobs)
+	repositorytest.Run(t, &repositorytest.Backend{
+		Account: repo.Account,
+		Item:    repo.Item,
+	})
+}
+
+// TestIndexData checks that item data stored before search was is indexed,
+// and then found by searches and content filters.
+func TestIndexData(t *testing.T) {
+	ctx := context.Background()
+	db, err := Connect("sqlite::memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	_, err = MigrateUp(ctx, db, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blobs, err := blob.NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewRepository(db, blobs)
+	acc := &repository.Account{ID: uuid.New(), Nickname: "indexer"}
+	err = repo.Account.Create(ctx, acc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var items []*repository.Item
+	for i := 0; i < 3; i++ {
+		item := &repository.Item{
+			TimeMs:  1600000000000 + uint64(i),
+			Data:    []byte(fmt.Sprintf(`{"blueprint":{"label":"furnaces %d"}}`, i)),
+			Kind:    "blueprint",
+			Version: 281479274823680,
+		}
+		err = repo.Item.Create(ctx, acc.ID, item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items = append(items, item)
+	}
+	_, err = MoveDataToBlobStore(ctx, db, blobs, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index := func(data []byte) *repository.SearchIndex {
+		if !bytes.Contains(data, []byte("furnaces")) {
+			t.Errorf("indexed data %q", data)
+		}
+		return &repository.SearchIndex{Text: "smelting", Entities: []string{"stone-furnace"}}
+	}
+	n, err := IndexData(ctx, db, blobs, 2, index)
+	if err != nil || n != len(items) {
+		t.Fatalf("IndexData = %d, %v, want %d", n, err, len(items))
+	}
+	n, err = IndexData(ctx, db, blobs, 2, index)
+	if err != nil || n != 0 {
+		t.Errorf("IndexData of indexed data = %d, %v, want 0", n, err)
+	}
+
+	results, total, err := repo.Item.Search(ctx, acc.ID, "smelting", repository.SearchOptions{})
+	if err != nil || total != len(items) || len(results) != len(items) {
+		t.Errorf("Search returned %d of %d results, %v, want %d", len(results), total, err, len(items))
+	}
+	listed, total, err := repo.Item.List(ctx, acc.ID, repository.ListOptions{
+		Content: repository.ContentFilter{Entities: []string{"stone-furnace"}},
+	})
+	if err != nil || total != len(items) || len(listed) != len(items) {
+		t.Errorf("List by entity returned %d of %d items, %v, want %d", len(listed), total, err, len(items))
+	}
+}
+
+// TestCollectGarbage checks that garbage collection pages through the
+// unreferenced item data, and counts only the rows it deleted.
+func TestCollectGarbage(t *testing.T) {
+	ctx := context.Background()
+	db, err := Connect("sqlite::memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	_, err = MigrateUp(ctx, db, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := NewRepository(db, nil)
+	r := repo.Item.(*itemRepo)
+	acc := &repository.Account{ID: uuid.New(), Nickname: "collector"}
+	err = repo.Account.Create(ctx, acc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	referenced := &repository.Item{Data: []byte(`{"blueprint":{"label":"kept"}}`), Kind: "blueprint"}
+	err = repo.Item.Create(ctx, acc.ID, referenced)
+	if err != nil {
+		t.Fatal(err)
+	}
+	insertGarbage := func(n int) {
+		t.Helper()
+		tx, err := db.BeginTxx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer tx.Rollback()
+		for i := 0; i < n; i++ {
+			item := &repository.Item{Data: []byte(fmt.Sprintf(`{"blueprint":{"label":"garbage %d"}}`, i)), Kind: "blueprint"}
+			err = r.insertData(ctx, tx, item)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		err = tx.Commit()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	insertGarbage(5)
+	count, _, err := repo.Item.CollectGarbage(ctx, true)
+	if err != nil || count != 5 {
+		t.Fatalf("CollectGarbage dry run = %d, %v, want 5", count, err)
+	}
+	n, size, err := r.collectGarbage(ctx, 2)
+	if err != nil || n != 5 || size <= 0 {
+		t.Errorf("collectGarbage = %d, %d, %v, want 5 rows", n, size, err)
+	}
+	n, _, err = r.collectGarbage(ctx, 2)
+	if err != nil || n != 0 {
+		t.Errorf("collectGarbage without garbage = %d, %v, want 0", n, err)
+	}
+	_, err = repo.Item.Get(ctx, acc.ID, referenced.ULID)
+	if err != nil {
+		t.Errorf("referenced item: %v", err)
+	}
+
+	insertGarbage(1)
+	garbage := sha256.Sum256([]byte(`{"blueprint":{"label":"garbage 0"}}`))
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback()
+	n, _, err = r.deleteUnreferenced(ctx, tx, [][]byte{referenced.Sum256[:], garbage[:]})
+	if err != nil || n != 1 {
+		t.Errorf("deleteUnreferenced of referenced and unreferenced data = %d, %v, want 1", n, err)
+	}
+}
@@ -0,0 +1,28 @@
+package sql
+
+import (
+	"strconv"
+	"strings"
+)
+
+// where builds the WHERE clause of a query from conditions using ? for their
+// arguments.
+type where struct {
+	conds []string
+	args  []interface{}
+}
+
+func (w *where) add(cond string, args ...interface{}) {
+	for _, arg := range args {
+		w.args = append(w.args, arg)
+		cond = strings.Replace(cond, "?", "$"+strconv.Itoa(len(w.args)), 1)
+	}
+	w.conds = append(w.conds, cond)
+}
+
+func (w *where) String() string {
+	if len(w.conds) == 0 {
+		return "TRUE"
+	}
+	return strings.Join(w.conds, " AND ")
+}
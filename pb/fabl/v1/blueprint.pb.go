@@ -8,6 +8,7 @@ package pb
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -30,9 +31,21 @@ type Blueprint struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	Item    string `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	Label   string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Version                uint64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Item                   string    `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Label                  string    `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	LabelColor             *Color    `protobuf:"bytes,4,opt,name=label_color,json=labelColor,proto3" json:"label_color,omitempty"`
+	Icons                  []*Icon   `protobuf:"bytes,5,rep,name=icons,proto3" json:"icons,omitempty"`
+	Entities               []*Entity `protobuf:"bytes,6,rep,name=entities,proto3" json:"entities,omitempty"`
+	Tiles                  []*Tile   `protobuf:"bytes,7,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	Description            string    `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	SnapToGrid             *Position `protobuf:"bytes,9,opt,name=snap_to_grid,json=snapToGrid,proto3" json:"snap_to_grid,omitempty"`
+	AbsoluteSnapping       bool      `protobuf:"varint,10,opt,name=absolute_snapping,json=absoluteSnapping,proto3" json:"absolute_snapping,omitempty"`
+	PositionRelativeToGrid *Position `protobuf:"bytes,11,opt,name=position_relative_to_grid,json=positionRelativeToGrid,proto3" json:"position_relative_to_grid,omitempty"`
+	// Decoded from version, ignored when encoding.
+	GameVersion *GameVersion `protobuf:"bytes,12,opt,name=game_version,json=gameVersion,proto3" json:"game_version,omitempty"`
+	// All other blueprint properties, as found in the import string.
+	Extra *_struct.Struct `protobuf:"bytes,15,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
 func (x *Blueprint) Reset() {
@@ -88,19 +101,130 @@ func (x *Blueprint) GetLabel() string {
 	return ""
 }
 
+func (x *Blueprint) GetLabelColor() *Color {
+	if x != nil {
+		return x.LabelColor
+	}
+	return nil
+}
+
+func (x *Blueprint) GetIcons() []*Icon {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+func (x *Blueprint) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *Blueprint) GetTiles() []*Tile {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *Blueprint) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Blueprint) GetSnapToGrid() *Position {
+	if x != nil {
+		return x.SnapToGrid
+	}
+	return nil
+}
+
+func (x *Blueprint) GetAbsoluteSnapping() bool {
+	if x != nil {
+		return x.AbsoluteSnapping
+	}
+	return false
+}
+
+func (x *Blueprint) GetPositionRelativeToGrid() *Position {
+	if x != nil {
+		return x.PositionRelativeToGrid
+	}
+	return nil
+}
+
+func (x *Blueprint) GetGameVersion() *GameVersion {
+	if x != nil {
+		return x.GameVersion
+	}
+	return nil
+}
+
+func (x *Blueprint) GetExtra() *_struct.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
 var File_fabl_v1_blueprint_proto protoreflect.FileDescriptor
 
 var file_fabl_v1_blueprint_proto_rawDesc = []byte{
 	0x0a, 0x17, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
 	0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e,
-	0x76, 0x31, 0x22, 0x4f, 0x0a, 0x09, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e,
-	0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x13, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x66, 0x61, 0x62,
+	0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31,
+	0x2f, 0x69, 0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x66, 0x61, 0x62,
+	0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x69, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x04, 0x0a, 0x09, 0x42, 0x6c, 0x75, 0x65,
+	0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x0b, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x0a,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x63,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x12,
+	0x2b, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x05,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61,
+	0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x67,
+	0x72, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x6e,
+	0x61, 0x70, 0x54, 0x6f, 0x47, 0x72, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x4c, 0x0a, 0x19, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x72,
+	0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x6f, 0x47,
+	0x72, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x61, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x67, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x1c, 0x5a, 0x1a, 0x61,
+	0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66,
+	0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -117,14 +241,29 @@ func file_fabl_v1_blueprint_proto_rawDescGZIP() []byte {
 
 var file_fabl_v1_blueprint_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_fabl_v1_blueprint_proto_goTypes = []interface{}{
-	(*Blueprint)(nil), // 0: fabl.v1.Blueprint
+	(*Blueprint)(nil),      // 0: fabl.v1.Blueprint
+	(*Color)(nil),          // 1: fabl.v1.Color
+	(*Icon)(nil),           // 2: fabl.v1.Icon
+	(*Entity)(nil),         // 3: fabl.v1.Entity
+	(*Tile)(nil),           // 4: fabl.v1.Tile
+	(*Position)(nil),       // 5: fabl.v1.Position
+	(*GameVersion)(nil),    // 6: fabl.v1.GameVersion
+	(*_struct.Struct)(nil), // 7: google.protobuf.Struct
 }
 var file_fabl_v1_blueprint_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: fabl.v1.Blueprint.label_color:type_name -> fabl.v1.Color
+	2, // 1: fabl.v1.Blueprint.icons:type_name -> fabl.v1.Icon
+	3, // 2: fabl.v1.Blueprint.entities:type_name -> fabl.v1.Entity
+	4, // 3: fabl.v1.Blueprint.tiles:type_name -> fabl.v1.Tile
+	5, // 4: fabl.v1.Blueprint.snap_to_grid:type_name -> fabl.v1.Position
+	5, // 5: fabl.v1.Blueprint.position_relative_to_grid:type_name -> fabl.v1.Position
+	6, // 6: fabl.v1.Blueprint.game_version:type_name -> fabl.v1.GameVersion
+	7, // 7: fabl.v1.Blueprint.extra:type_name -> google.protobuf.Struct
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_fabl_v1_blueprint_proto_init() }
@@ -132,6 +271,12 @@ func file_fabl_v1_blueprint_proto_init() {
 	if File_fabl_v1_blueprint_proto != nil {
 		return
 	}
+	file_fabl_v1_color_proto_init()
+	file_fabl_v1_entity_proto_init()
+	file_fabl_v1_game_version_proto_init()
+	file_fabl_v1_icon_proto_init()
+	file_fabl_v1_position_proto_init()
+	file_fabl_v1_tile_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_fabl_v1_blueprint_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Blueprint); i {
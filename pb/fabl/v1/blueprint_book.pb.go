@@ -8,6 +8,7 @@ package pb
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -33,8 +34,15 @@ type BlueprintBook struct {
 	Version     uint64                `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	Item        string                `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
 	Label       string                `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	LabelColor  *Color                `protobuf:"bytes,4,opt,name=label_color,json=labelColor,proto3" json:"label_color,omitempty"`
+	Icons       []*Icon               `protobuf:"bytes,5,rep,name=icons,proto3" json:"icons,omitempty"`
 	ActiveIndex uint64                `protobuf:"varint,6,opt,name=active_index,json=activeIndex,proto3" json:"active_index,omitempty"`
 	Blueprints  []*BlueprintBookEntry `protobuf:"bytes,7,rep,name=blueprints,proto3" json:"blueprints,omitempty"`
+	Description string                `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// Decoded from version, ignored when encoding.
+	GameVersion *GameVersion `protobuf:"bytes,9,opt,name=game_version,json=gameVersion,proto3" json:"game_version,omitempty"`
+	// All other blueprint book properties, as found in the import string.
+	Extra *_struct.Struct `protobuf:"bytes,15,opt,name=extra,proto3" json:"extra,omitempty"`
 }
 
 func (x *BlueprintBook) Reset() {
@@ -90,6 +98,20 @@ func (x *BlueprintBook) GetLabel() string {
 	return ""
 }
 
+func (x *BlueprintBook) GetLabelColor() *Color {
+	if x != nil {
+		return x.LabelColor
+	}
+	return nil
+}
+
+func (x *BlueprintBook) GetIcons() []*Icon {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
 func (x *BlueprintBook) GetActiveIndex() uint64 {
 	if x != nil {
 		return x.ActiveIndex
@@ -104,28 +126,223 @@ func (x *BlueprintBook) GetBlueprints() []*BlueprintBookEntry {
 	return nil
 }
 
+func (x *BlueprintBook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BlueprintBook) GetGameVersion() *GameVersion {
+	if x != nil {
+		return x.GameVersion
+	}
+	return nil
+}
+
+func (x *BlueprintBook) GetExtra() *_struct.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// BlueprintBookEntry is defined here, as books can contain books.
+type BlueprintBookEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Types that are assignable to Entry:
+	//	*BlueprintBookEntry_Blueprint
+	//	*BlueprintBookEntry_BlueprintBook
+	//	*BlueprintBookEntry_DeconstructionPlanner
+	//	*BlueprintBookEntry_UpgradePlanner
+	Entry isBlueprintBookEntry_Entry `protobuf_oneof:"entry"`
+	// All other entry properties, as found in the import string. Objects of
+	// unrecognized kinds are kept here, with the entry left unset.
+	Extra *_struct.Struct `protobuf:"bytes,15,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *BlueprintBookEntry) Reset() {
+	*x = BlueprintBookEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_blueprint_book_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlueprintBookEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlueprintBookEntry) ProtoMessage() {}
+
+func (x *BlueprintBookEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_blueprint_book_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlueprintBookEntry.ProtoReflect.Descriptor instead.
+func (*BlueprintBookEntry) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_blueprint_book_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlueprintBookEntry) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (m *BlueprintBookEntry) GetEntry() isBlueprintBookEntry_Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (x *BlueprintBookEntry) GetBlueprint() *Blueprint {
+	if x, ok := x.GetEntry().(*BlueprintBookEntry_Blueprint); ok {
+		return x.Blueprint
+	}
+	return nil
+}
+
+func (x *BlueprintBookEntry) GetBlueprintBook() *BlueprintBook {
+	if x, ok := x.GetEntry().(*BlueprintBookEntry_BlueprintBook); ok {
+		return x.BlueprintBook
+	}
+	return nil
+}
+
+func (x *BlueprintBookEntry) GetDeconstructionPlanner() *DeconstructionPlanner {
+	if x, ok := x.GetEntry().(*BlueprintBookEntry_DeconstructionPlanner); ok {
+		return x.DeconstructionPlanner
+	}
+	return nil
+}
+
+func (x *BlueprintBookEntry) GetUpgradePlanner() *UpgradePlanner {
+	if x, ok := x.GetEntry().(*BlueprintBookEntry_UpgradePlanner); ok {
+		return x.UpgradePlanner
+	}
+	return nil
+}
+
+func (x *BlueprintBookEntry) GetExtra() *_struct.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type isBlueprintBookEntry_Entry interface {
+	isBlueprintBookEntry_Entry()
+}
+
+type BlueprintBookEntry_Blueprint struct {
+	Blueprint *Blueprint `protobuf:"bytes,2,opt,name=blueprint,proto3,oneof"`
+}
+
+type BlueprintBookEntry_BlueprintBook struct {
+	BlueprintBook *BlueprintBook `protobuf:"bytes,3,opt,name=blueprint_book,json=blueprintBook,proto3,oneof"`
+}
+
+type BlueprintBookEntry_DeconstructionPlanner struct {
+	DeconstructionPlanner *DeconstructionPlanner `protobuf:"bytes,4,opt,name=deconstruction_planner,json=deconstructionPlanner,proto3,oneof"`
+}
+
+type BlueprintBookEntry_UpgradePlanner struct {
+	UpgradePlanner *UpgradePlanner `protobuf:"bytes,5,opt,name=upgrade_planner,json=upgradePlanner,proto3,oneof"`
+}
+
+func (*BlueprintBookEntry_Blueprint) isBlueprintBookEntry_Entry() {}
+
+func (*BlueprintBookEntry_BlueprintBook) isBlueprintBookEntry_Entry() {}
+
+func (*BlueprintBookEntry_DeconstructionPlanner) isBlueprintBookEntry_Entry() {}
+
+func (*BlueprintBookEntry_UpgradePlanner) isBlueprintBookEntry_Entry() {}
+
 var File_fabl_v1_blueprint_book_proto protoreflect.FileDescriptor
 
 var file_fabl_v1_blueprint_book_proto_rawDesc = []byte{
 	0x0a, 0x1c, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
 	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
-	0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x22, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31,
-	0x2f, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x65, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb3, 0x01, 0x0a, 0x0d,
-	0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65,
-	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e,
-	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x73, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70,
-	0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x62,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13,
+	0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x66, 0x61, 0x62, 0x6c, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x69,
+	0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x66, 0x61, 0x62, 0x6c, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93, 0x03, 0x0a, 0x0d, 0x42, 0x6c, 0x75,
+	0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2f,
+	0x0a, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x52, 0x0a, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x23, 0x0a, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x05, 0x69,
+	0x63, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x0a, 0x62, 0x6c, 0x75, 0x65, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61,
+	0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42,
+	0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66,
+	0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x22, 0xf4,
+	0x02, 0x0a, 0x12, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32, 0x0a, 0x09, 0x62,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
+	0x3f, 0x0a, 0x0e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x48,
+	0x00, 0x52, 0x0d, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b,
+	0x12, 0x57, 0x0a, 0x16, 0x64, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x75, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x2d, 0x0a,
+	0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x42, 0x07, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62,
+	0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31,
+	0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -140,18 +357,34 @@ func file_fabl_v1_blueprint_book_proto_rawDescGZIP() []byte {
 	return file_fabl_v1_blueprint_book_proto_rawDescData
 }
 
-var file_fabl_v1_blueprint_book_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fabl_v1_blueprint_book_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_fabl_v1_blueprint_book_proto_goTypes = []interface{}{
-	(*BlueprintBook)(nil),      // 0: fabl.v1.BlueprintBook
-	(*BlueprintBookEntry)(nil), // 1: fabl.v1.BlueprintBookEntry
+	(*BlueprintBook)(nil),         // 0: fabl.v1.BlueprintBook
+	(*BlueprintBookEntry)(nil),    // 1: fabl.v1.BlueprintBookEntry
+	(*Color)(nil),                 // 2: fabl.v1.Color
+	(*Icon)(nil),                  // 3: fabl.v1.Icon
+	(*GameVersion)(nil),           // 4: fabl.v1.GameVersion
+	(*_struct.Struct)(nil),        // 5: google.protobuf.Struct
+	(*Blueprint)(nil),             // 6: fabl.v1.Blueprint
+	(*DeconstructionPlanner)(nil), // 7: fabl.v1.DeconstructionPlanner
+	(*UpgradePlanner)(nil),        // 8: fabl.v1.UpgradePlanner
 }
 var file_fabl_v1_blueprint_book_proto_depIdxs = []int32{
-	1, // 0: fabl.v1.BlueprintBook.blueprints:type_name -> fabl.v1.BlueprintBookEntry
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: fabl.v1.BlueprintBook.label_color:type_name -> fabl.v1.Color
+	3,  // 1: fabl.v1.BlueprintBook.icons:type_name -> fabl.v1.Icon
+	1,  // 2: fabl.v1.BlueprintBook.blueprints:type_name -> fabl.v1.BlueprintBookEntry
+	4,  // 3: fabl.v1.BlueprintBook.game_version:type_name -> fabl.v1.GameVersion
+	5,  // 4: fabl.v1.BlueprintBook.extra:type_name -> google.protobuf.Struct
+	6,  // 5: fabl.v1.BlueprintBookEntry.blueprint:type_name -> fabl.v1.Blueprint
+	0,  // 6: fabl.v1.BlueprintBookEntry.blueprint_book:type_name -> fabl.v1.BlueprintBook
+	7,  // 7: fabl.v1.BlueprintBookEntry.deconstruction_planner:type_name -> fabl.v1.DeconstructionPlanner
+	8,  // 8: fabl.v1.BlueprintBookEntry.upgrade_planner:type_name -> fabl.v1.UpgradePlanner
+	5,  // 9: fabl.v1.BlueprintBookEntry.extra:type_name -> google.protobuf.Struct
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_fabl_v1_blueprint_book_proto_init() }
@@ -159,7 +392,12 @@ func file_fabl_v1_blueprint_book_proto_init() {
 	if File_fabl_v1_blueprint_book_proto != nil {
 		return
 	}
-	file_fabl_v1_blueprint_book_entry_proto_init()
+	file_fabl_v1_blueprint_proto_init()
+	file_fabl_v1_color_proto_init()
+	file_fabl_v1_deconstruction_planner_proto_init()
+	file_fabl_v1_game_version_proto_init()
+	file_fabl_v1_icon_proto_init()
+	file_fabl_v1_upgrade_planner_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_fabl_v1_blueprint_book_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlueprintBook); i {
@@ -173,6 +411,24 @@ func file_fabl_v1_blueprint_book_proto_init() {
 				return nil
 			}
 		}
+		file_fabl_v1_blueprint_book_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlueprintBookEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_fabl_v1_blueprint_book_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*BlueprintBookEntry_Blueprint)(nil),
+		(*BlueprintBookEntry_BlueprintBook)(nil),
+		(*BlueprintBookEntry_DeconstructionPlanner)(nil),
+		(*BlueprintBookEntry_UpgradePlanner)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -180,7 +436,7 @@ func file_fabl_v1_blueprint_book_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fabl_v1_blueprint_book_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,177 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/color.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Color struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	R float64 `protobuf:"fixed64,1,opt,name=r,proto3" json:"r,omitempty"`
+	G float64 `protobuf:"fixed64,2,opt,name=g,proto3" json:"g,omitempty"`
+	B float64 `protobuf:"fixed64,3,opt,name=b,proto3" json:"b,omitempty"`
+	// Unset when the import string has no alpha, which Factorio reads as
+	// opaque.
+	A *float64 `protobuf:"fixed64,4,opt,name=a,proto3,oneof" json:"a,omitempty"`
+}
+
+func (x *Color) Reset() {
+	*x = Color{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_color_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Color) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Color) ProtoMessage() {}
+
+func (x *Color) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_color_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Color.ProtoReflect.Descriptor instead.
+func (*Color) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_color_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Color) GetR() float64 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *Color) GetG() float64 {
+	if x != nil {
+		return x.G
+	}
+	return 0
+}
+
+func (x *Color) GetB() float64 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *Color) GetA() float64 {
+	if x != nil && x.A != nil {
+		return *x.A
+	}
+	return 0
+}
+
+var File_fabl_v1_color_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_color_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x22, 0x4a,
+	0x0a, 0x05, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x01, 0x67, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x01,
+	0x62, 0x12, 0x11, 0x0a, 0x01, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x01,
+	0x61, 0x88, 0x01, 0x01, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x61, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70,
+	0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61,
+	0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_color_proto_rawDescOnce sync.Once
+	file_fabl_v1_color_proto_rawDescData = file_fabl_v1_color_proto_rawDesc
+)
+
+func file_fabl_v1_color_proto_rawDescGZIP() []byte {
+	file_fabl_v1_color_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_color_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_color_proto_rawDescData)
+	})
+	return file_fabl_v1_color_proto_rawDescData
+}
+
+var file_fabl_v1_color_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fabl_v1_color_proto_goTypes = []interface{}{
+	(*Color)(nil), // 0: fabl.v1.Color
+}
+var file_fabl_v1_color_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_color_proto_init() }
+func file_fabl_v1_color_proto_init() {
+	if File_fabl_v1_color_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fabl_v1_color_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Color); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_fabl_v1_color_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_color_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_color_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_color_proto_depIdxs,
+		MessageInfos:      file_fabl_v1_color_proto_msgTypes,
+	}.Build()
+	File_fabl_v1_color_proto = out.File
+	file_fabl_v1_color_proto_rawDesc = nil
+	file_fabl_v1_color_proto_goTypes = nil
+	file_fabl_v1_color_proto_depIdxs = nil
+}
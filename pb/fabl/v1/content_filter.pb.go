@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/content_filter.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// ContentFilter matches items by the entities and recipes of their
+// blueprints, including those in books.
+type ContentFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only match items containing all of these entities, e.g. "beacon".
+	Entities []string `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	// Only match items with assemblers set to all of these recipes.
+	Recipes []string `protobuf:"bytes,2,rep,name=recipes,proto3" json:"recipes,omitempty"`
+	// Only match items containing none of these entities.
+	ExcludedEntities []string `protobuf:"bytes,3,rep,name=excluded_entities,json=excludedEntities,proto3" json:"excluded_entities,omitempty"`
+	// Only match items using base game entities only.
+	NoModdedEntities bool `protobuf:"varint,4,opt,name=no_modded_entities,json=noModdedEntities,proto3" json:"no_modded_entities,omitempty"`
+}
+
+func (x *ContentFilter) Reset() {
+	*x = ContentFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_content_filter_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContentFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentFilter) ProtoMessage() {}
+
+func (x *ContentFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_content_filter_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentFilter.ProtoReflect.Descriptor instead.
+func (*ContentFilter) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_content_filter_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ContentFilter) GetEntities() []string {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+func (x *ContentFilter) GetRecipes() []string {
+	if x != nil {
+		return x.Recipes
+	}
+	return nil
+}
+
+func (x *ContentFilter) GetExcludedEntities() []string {
+	if x != nil {
+		return x.ExcludedEntities
+	}
+	return nil
+}
+
+func (x *ContentFilter) GetNoModdedEntities() bool {
+	if x != nil {
+		return x.NoModdedEntities
+	}
+	return false
+}
+
+var File_fabl_v1_content_filter_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_content_filter_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07,
+	0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x22, 0xa0, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x6f, 0x5f, 0x6d, 0x6f, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x6f, 0x4d, 0x6f, 0x64, 0x64,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70,
+	0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61,
+	0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_content_filter_proto_rawDescOnce sync.Once
+	file_fabl_v1_content_filter_proto_rawDescData = file_fabl_v1_content_filter_proto_rawDesc
+)
+
+func file_fabl_v1_content_filter_proto_rawDescGZIP() []byte {
+	file_fabl_v1_content_filter_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_content_filter_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_content_filter_proto_rawDescData)
+	})
+	return file_fabl_v1_content_filter_proto_rawDescData
+}
+
+var file_fabl_v1_content_filter_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fabl_v1_content_filter_proto_goTypes = []interface{}{
+	(*ContentFilter)(nil), // 0: fabl.v1.ContentFilter
+}
+var file_fabl_v1_content_filter_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_content_filter_proto_init() }
+func file_fabl_v1_content_filter_proto_init() {
+	if File_fabl_v1_content_filter_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_fabl_v1_content_filter_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContentFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_content_filter_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_content_filter_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_content_filter_proto_depIdxs,
+		MessageInfos:      file_fabl_v1_content_filter_proto_msgTypes,
+	}.Build()
+	File_fabl_v1_content_filter_proto = out.File
+	file_fabl_v1_content_filter_proto_rawDesc = nil
+	file_fabl_v1_content_filter_proto_goTypes = nil
+	file_fabl_v1_content_filter_proto_depIdxs = nil
+}
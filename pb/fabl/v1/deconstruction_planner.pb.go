@@ -0,0 +1,511 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/deconstruction_planner.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type DeconstructionPlanner_FilterMode int32
+
+const (
+	DeconstructionPlanner_FILTER_MODE_WHITELIST DeconstructionPlanner_FilterMode = 0
+	DeconstructionPlanner_FILTER_MODE_BLACKLIST DeconstructionPlanner_FilterMode = 1
+)
+
+// Enum value maps for DeconstructionPlanner_FilterMode.
+var (
+	DeconstructionPlanner_FilterMode_name = map[int32]string{
+		0: "FILTER_MODE_WHITELIST",
+		1: "FILTER_MODE_BLACKLIST",
+	}
+	DeconstructionPlanner_FilterMode_value = map[string]int32{
+		"FILTER_MODE_WHITELIST": 0,
+		"FILTER_MODE_BLACKLIST": 1,
+	}
+)
+
+func (x DeconstructionPlanner_FilterMode) Enum() *DeconstructionPlanner_FilterMode {
+	p := new(DeconstructionPlanner_FilterMode)
+	*p = x
+	return p
+}
+
+func (x DeconstructionPlanner_FilterMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeconstructionPlanner_FilterMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_fabl_v1_deconstruction_planner_proto_enumTypes[0].Descriptor()
+}
+
+func (DeconstructionPlanner_FilterMode) Type() protoreflect.EnumType {
+	return &file_fabl_v1_deconstruction_planner_proto_enumTypes[0]
+}
+
+func (x DeconstructionPlanner_FilterMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeconstructionPlanner_FilterMode.Descriptor instead.
+func (DeconstructionPlanner_FilterMode) EnumDescriptor() ([]byte, []int) {
+	return file_fabl_v1_deconstruction_planner_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type DeconstructionPlanner_TileSelectionMode int32
+
+const (
+	DeconstructionPlanner_TILE_SELECTION_MODE_NORMAL DeconstructionPlanner_TileSelectionMode = 0
+	DeconstructionPlanner_TILE_SELECTION_MODE_ALWAYS DeconstructionPlanner_TileSelectionMode = 1
+	DeconstructionPlanner_TILE_SELECTION_MODE_NEVER  DeconstructionPlanner_TileSelectionMode = 2
+	DeconstructionPlanner_TILE_SELECTION_MODE_ONLY   DeconstructionPlanner_TileSelectionMode = 3
+)
+
+// Enum value maps for DeconstructionPlanner_TileSelectionMode.
+var (
+	DeconstructionPlanner_TileSelectionMode_name = map[int32]string{
+		0: "TILE_SELECTION_MODE_NORMAL",
+		1: "TILE_SELECTION_MODE_ALWAYS",
+		2: "TILE_SELECTION_MODE_NEVER",
+		3: "TILE_SELECTION_MODE_ONLY",
+	}
+	DeconstructionPlanner_TileSelectionMode_value = map[string]int32{
+		"TILE_SELECTION_MODE_NORMAL": 0,
+		"TILE_SELECTION_MODE_ALWAYS": 1,
+		"TILE_SELECTION_MODE_NEVER":  2,
+		"TILE_SELECTION_MODE_ONLY":   3,
+	}
+)
+
+func (x DeconstructionPlanner_TileSelectionMode) Enum() *DeconstructionPlanner_TileSelectionMode {
+	p := new(DeconstructionPlanner_TileSelectionMode)
+	*p = x
+	return p
+}
+
+func (x DeconstructionPlanner_TileSelectionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeconstructionPlanner_TileSelectionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_fabl_v1_deconstruction_planner_proto_enumTypes[1].Descriptor()
+}
+
+func (DeconstructionPlanner_TileSelectionMode) Type() protoreflect.EnumType {
+	return &file_fabl_v1_deconstruction_planner_proto_enumTypes[1]
+}
+
+func (x DeconstructionPlanner_TileSelectionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeconstructionPlanner_TileSelectionMode.Descriptor instead.
+func (DeconstructionPlanner_TileSelectionMode) EnumDescriptor() ([]byte, []int) {
+	return file_fabl_v1_deconstruction_planner_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type DeconstructionPlanner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version           uint64                                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Item              string                                  `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Label             string                                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Icons             []*Icon                                 `protobuf:"bytes,5,rep,name=icons,proto3" json:"icons,omitempty"`
+	Description       string                                  `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	EntityFilterMode  DeconstructionPlanner_FilterMode        `protobuf:"varint,9,opt,name=entity_filter_mode,json=entityFilterMode,proto3,enum=fabl.v1.DeconstructionPlanner_FilterMode" json:"entity_filter_mode,omitempty"`
+	EntityFilters     []*DeconstructionPlanner_Filter         `protobuf:"bytes,10,rep,name=entity_filters,json=entityFilters,proto3" json:"entity_filters,omitempty"`
+	TreesAndRocksOnly bool                                    `protobuf:"varint,11,opt,name=trees_and_rocks_only,json=treesAndRocksOnly,proto3" json:"trees_and_rocks_only,omitempty"`
+	TileFilterMode    DeconstructionPlanner_FilterMode        `protobuf:"varint,12,opt,name=tile_filter_mode,json=tileFilterMode,proto3,enum=fabl.v1.DeconstructionPlanner_FilterMode" json:"tile_filter_mode,omitempty"`
+	TileFilters       []*DeconstructionPlanner_Filter         `protobuf:"bytes,13,rep,name=tile_filters,json=tileFilters,proto3" json:"tile_filters,omitempty"`
+	TileSelectionMode DeconstructionPlanner_TileSelectionMode `protobuf:"varint,14,opt,name=tile_selection_mode,json=tileSelectionMode,proto3,enum=fabl.v1.DeconstructionPlanner_TileSelectionMode" json:"tile_selection_mode,omitempty"`
+	// Decoded from version, ignored when encoding.
+	GameVersion *GameVersion `protobuf:"bytes,17,opt,name=game_version,json=gameVersion,proto3" json:"game_version,omitempty"`
+	// All other properties, as found in the import string.
+	Extra *_struct.Struct `protobuf:"bytes,15,opt,name=extra,proto3" json:"extra,omitempty"`
+	// All other settings, as found in the import string.
+	SettingsExtra *_struct.Struct `protobuf:"bytes,16,opt,name=settings_extra,json=settingsExtra,proto3" json:"settings_extra,omitempty"`
+}
+
+func (x *DeconstructionPlanner) Reset() {
+	*x = DeconstructionPlanner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_deconstruction_planner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeconstructionPlanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeconstructionPlanner) ProtoMessage() {}
+
+func (x *DeconstructionPlanner) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_deconstruction_planner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeconstructionPlanner.ProtoReflect.Descriptor instead.
+func (*DeconstructionPlanner) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_deconstruction_planner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeconstructionPlanner) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DeconstructionPlanner) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *DeconstructionPlanner) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DeconstructionPlanner) GetIcons() []*Icon {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+func (x *DeconstructionPlanner) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DeconstructionPlanner) GetEntityFilterMode() DeconstructionPlanner_FilterMode {
+	if x != nil {
+		return x.EntityFilterMode
+	}
+	return DeconstructionPlanner_FILTER_MODE_WHITELIST
+}
+
+func (x *DeconstructionPlanner) GetEntityFilters() []*DeconstructionPlanner_Filter {
+	if x != nil {
+		return x.EntityFilters
+	}
+	return nil
+}
+
+func (x *DeconstructionPlanner) GetTreesAndRocksOnly() bool {
+	if x != nil {
+		return x.TreesAndRocksOnly
+	}
+	return false
+}
+
+func (x *DeconstructionPlanner) GetTileFilterMode() DeconstructionPlanner_FilterMode {
+	if x != nil {
+		return x.TileFilterMode
+	}
+	return DeconstructionPlanner_FILTER_MODE_WHITELIST
+}
+
+func (x *DeconstructionPlanner) GetTileFilters() []*DeconstructionPlanner_Filter {
+	if x != nil {
+		return x.TileFilters
+	}
+	return nil
+}
+
+func (x *DeconstructionPlanner) GetTileSelectionMode() DeconstructionPlanner_TileSelectionMode {
+	if x != nil {
+		return x.TileSelectionMode
+	}
+	return DeconstructionPlanner_TILE_SELECTION_MODE_NORMAL
+}
+
+func (x *DeconstructionPlanner) GetGameVersion() *GameVersion {
+	if x != nil {
+		return x.GameVersion
+	}
+	return nil
+}
+
+func (x *DeconstructionPlanner) GetExtra() *_struct.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *DeconstructionPlanner) GetSettingsExtra() *_struct.Struct {
+	if x != nil {
+		return x.SettingsExtra
+	}
+	return nil
+}
+
+type DeconstructionPlanner_Filter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeconstructionPlanner_Filter) Reset() {
+	*x = DeconstructionPlanner_Filter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_deconstruction_planner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeconstructionPlanner_Filter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeconstructionPlanner_Filter) ProtoMessage() {}
+
+func (x *DeconstructionPlanner_Filter) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_deconstruction_planner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeconstructionPlanner_Filter.ProtoReflect.Descriptor instead.
+func (*DeconstructionPlanner_Filter) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_deconstruction_planner_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *DeconstructionPlanner_Filter) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DeconstructionPlanner_Filter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+var File_fabl_v1_deconstruction_planner_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_deconstruction_planner_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x66,
+	0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x66, 0x61, 0x62, 0x6c, 0x2f,
+	0x76, 0x31, 0x2f, 0x69, 0x63, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x08,
+	0x0a, 0x15, 0x44, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x05, 0x69,
+	0x63, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x12, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x10, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x0e, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x72, 0x65,
+	0x65, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x74, 0x72, 0x65, 0x65, 0x73, 0x41, 0x6e,
+	0x64, 0x52, 0x6f, 0x63, 0x6b, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x53, 0x0a, 0x10, 0x74, 0x69,
+	0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x0e, 0x74, 0x69, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x48, 0x0a, 0x0c, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x74, 0x69,
+	0x6c, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x60, 0x0a, 0x13, 0x74, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x11, 0x74, 0x69, 0x6c, 0x65, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x12, 0x3e, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f,
+	0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x1a, 0x32, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f,
+	0x42, 0x4c, 0x41, 0x43, 0x4b, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x11,
+	0x54, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x10,
+	0x01, 0x12, 0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x02,
+	0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x42, 0x1c,
+	0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70,
+	0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_deconstruction_planner_proto_rawDescOnce sync.Once
+	file_fabl_v1_deconstruction_planner_proto_rawDescData = file_fabl_v1_deconstruction_planner_proto_rawDesc
+)
+
+func file_fabl_v1_deconstruction_planner_proto_rawDescGZIP() []byte {
+	file_fabl_v1_deconstruction_planner_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_deconstruction_planner_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_deconstruction_planner_proto_rawDescData)
+	})
+	return file_fabl_v1_deconstruction_planner_proto_rawDescData
+}
+
+var file_fabl_v1_deconstruction_planner_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_fabl_v1_deconstruction_planner_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fabl_v1_deconstruction_planner_proto_goTypes = []interface{}{
+	(DeconstructionPlanner_FilterMode)(0),        // 0: fabl.v1.DeconstructionPlanner.FilterMode
+	(DeconstructionPlanner_TileSelectionMode)(0), // 1: fabl.v1.DeconstructionPlanner.TileSelectionMode
+	(*DeconstructionPlanner)(nil),                // 2: fabl.v1.DeconstructionPlanner
+	(*DeconstructionPlanner_Filter)(nil),         // 3: fabl.v1.DeconstructionPlanner.Filter
+	(*Icon)(nil),                                 // 4: fabl.v1.Icon
+	(*GameVersion)(nil),                          // 5: fabl.v1.GameVersion
+	(*_struct.Struct)(nil),                       // 6: google.protobuf.Struct
+}
+var file_fabl_v1_deconstruction_planner_proto_depIdxs = []int32{
+	4, // 0: fabl.v1.DeconstructionPlanner.icons:type_name -> fabl.v1.Icon
+	0, // 1: fabl.v1.DeconstructionPlanner.entity_filter_mode:type_name -> fabl.v1.DeconstructionPlanner.FilterMode
+	3, // 2: fabl.v1.DeconstructionPlanner.entity_filters:type_name -> fabl.v1.DeconstructionPlanner.Filter
+	0, // 3: fabl.v1.DeconstructionPlanner.tile_filter_mode:type_name -> fabl.v1.DeconstructionPlanner.FilterMode
+	3, // 4: fabl.v1.DeconstructionPlanner.tile_filters:type_name -> fabl.v1.DeconstructionPlanner.Filter
+	1, // 5: fabl.v1.DeconstructionPlanner.tile_selection_mode:type_name -> fabl.v1.DeconstructionPlanner.TileSelectionMode
+	5, // 6: fabl.v1.DeconstructionPlanner.game_version:type_name -> fabl.v1.GameVersion
+	6, // 7: fabl.v1.DeconstructionPlanner.extra:type_name -> google.protobuf.Struct
+	6, // 8: fabl.v1.DeconstructionPlanner.settings_extra:type_name -> google.protobuf.Struct
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_deconstruction_planner_proto_init() }
+func file_fabl_v1_deconstruction_planner_proto_init() {
+	if File_fabl_v1_deconstruction_planner_proto != nil {
+		return
+	}
+	file_fabl_v1_game_version_proto_init()
+	file_fabl_v1_icon_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fabl_v1_deconstruction_planner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeconstructionPlanner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fabl_v1_deconstruction_planner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeconstructionPlanner_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_deconstruction_planner_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_deconstruction_planner_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_deconstruction_planner_proto_depIdxs,
+		EnumInfos:         file_fabl_v1_deconstruction_planner_proto_enumTypes,
+		MessageInfos:      file_fabl_v1_deconstruction_planner_proto_msgTypes,
+	}.Build()
+	File_fabl_v1_deconstruction_planner_proto = out.File
+	file_fabl_v1_deconstruction_planner_proto_rawDesc = nil
+	file_fabl_v1_deconstruction_planner_proto_goTypes = nil
+	file_fabl_v1_deconstruction_planner_proto_depIdxs = nil
+}
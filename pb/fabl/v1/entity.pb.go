@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/entity.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Entity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityNumber    uint32              `protobuf:"varint,1,opt,name=entity_number,json=entityNumber,proto3" json:"entity_number,omitempty"`
+	Name            string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position        *Position           `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	Direction       uint32              `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Items           map[string]uint32   `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Recipe          string              `protobuf:"bytes,6,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	ControlBehavior *_struct.Struct     `protobuf:"bytes,7,opt,name=control_behavior,json=controlBehavior,proto3" json:"control_behavior,omitempty"`
+	Connections     *Entity_Connections `protobuf:"bytes,8,opt,name=connections,proto3" json:"connections,omitempty"`
+	// All other entity properties, as found in the import string.
+	Extra *_struct.Struct `protobuf:"bytes,15,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_entity_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_entity_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_entity_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Entity) GetEntityNumber() uint32 {
+	if x != nil {
+		return x.EntityNumber
+	}
+	return 0
+}
+
+func (x *Entity) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Entity) GetPosition() *Position {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *Entity) GetDirection() uint32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *Entity) GetItems() map[string]uint32 {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Entity) GetRecipe() string {
+	if x != nil {
+		return x.Recipe
+	}
+	return ""
+}
+
+func (x *Entity) GetControlBehavior() *_struct.Struct {
+	if x != nil {
+		return x.ControlBehavior
+	}
+	return nil
+}
+
+func (x *Entity) GetConnections() *Entity_Connections {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *Entity) GetExtra() *_struct.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type Entity_Connection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntityId  uint32 `protobuf:"varint,1,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	CircuitId uint32 `protobuf:"varint,2,opt,name=circuit_id,json=circuitId,proto3" json:"circuit_id,omitempty"`
+	// Only used by copper connections.
+	WireId uint32 `protobuf:"varint,3,opt,name=wire_id,json=wireId,proto3" json:"wire_id,omitempty"`
+}
+
+func (x *Entity_Connection) Reset() {
+	*x = Entity_Connection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_entity_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity_Connection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity_Connection) ProtoMessage() {}
+
+func (x *Entity_Connection) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_entity_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity_Connection.ProtoReflect.Descriptor instead.
+func (*Entity_Connection) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_entity_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Entity_Connection) GetEntityId() uint32 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *Entity_Connection) GetCircuitId() uint32 {
+	if x != nil {
+		return x.CircuitId
+	}
+	return 0
+}
+
+func (x *Entity_Connection) GetWireId() uint32 {
+	if x != nil {
+		return x.WireId
+	}
+	return 0
+}
+
+type Entity_ConnectionPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Red   []*Entity_Connection `protobuf:"bytes,1,rep,name=red,proto3" json:"red,omitempty"`
+	Green []*Entity_Connection `protobuf:"bytes,2,rep,name=green,proto3" json:"green,omitempty"`
+}
+
+func (x *Entity_ConnectionPoint) Reset() {
+	*x = Entity_ConnectionPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_entity_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity_ConnectionPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity_ConnectionPoint) ProtoMessage() {}
+
+func (x *Entity_ConnectionPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_entity_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity_ConnectionPoint.ProtoReflect.Descriptor instead.
+func (*Entity_ConnectionPoint) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_entity_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *Entity_ConnectionPoint) GetRed() []*Entity_Connection {
+	if x != nil {
+		return x.Red
+	}
+	return nil
+}
+
+func (x *Entity_ConnectionPoint) GetGreen() []*Entity_Connection {
+	if x != nil {
+		return x.Green
+	}
+	return nil
+}
+
+type Entity_Connections struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Circuit connection points "1" and "2".
+	Point_1 *Entity_ConnectionPoint `protobuf:"bytes,1,opt,name=point_1,json=point1,proto3" json:"point_1,omitempty"`
+	Point_2 *Entity_ConnectionPoint `protobuf:"bytes,2,opt,name=point_2,json=point2,proto3" json:"point_2,omitempty"`
+	// Copper wire connections of power switches.
+	Cu0 []*Entity_Connection `protobuf:"bytes,3,rep,name=cu0,proto3" json:"cu0,omitempty"`
+	Cu1 []*Entity_Connection `protobuf:"bytes,4,rep,name=cu1,proto3" json:"cu1,omitempty"`
+}
+
+func (x *Entity_Connections) Reset() {
+	*x = Entity_Connections{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_entity_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Entity_Connections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity_Connections) ProtoMessage() {}
+
+func (x *Entity_Connections) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_entity_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity_Connections.ProtoReflect.Descriptor instead.
+func (*Entity_Connections) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_entity_proto_rawDescGZIP(), []int{0, 2}
+}
+
+func (x *Entity_Connections) GetPoint_1() *Entity_ConnectionPoint {
+	if x != nil {
+		return x.Point_1
+	}
+	return nil
+}
+
+func (x *Entity_Connections) GetPoint_2() *Entity_ConnectionPoint {
+	if x != nil {
+		return x.Point_2
+	}
+	return nil
+}
+
+func (x *Entity_Connections) GetCu0() []*Entity_Connection {
+	if x != nil {
+		return x.Cu0
+	}
+	return nil
+}
+
+func (x *Entity_Connections) GetCu1() []*Entity_Connection {
+	if x != nil {
+		return x.Cu1
+	}
+	return nil
+}
+
+var File_fabl_v1_entity_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_entity_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x66,
+	0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x06, 0x0a, 0x06, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61,
+	0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x12, 0x42, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x61, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74,
+	0x72, 0x61, 0x1a, 0x61, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x77, 0x69, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77,
+	0x69, 0x72, 0x65, 0x49, 0x64, 0x1a, 0x71, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x72, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x03, 0x72, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x1a, 0xdd, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x61, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x31, 0x12, 0x38, 0x0a, 0x07, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x32, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x32, 0x12, 0x2c, 0x0a, 0x03,
+	0x63, 0x75, 0x30, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x62, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x63, 0x75, 0x30, 0x12, 0x2c, 0x0a, 0x03, 0x63, 0x75,
+	0x31, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x03, 0x63, 0x75, 0x31, 0x1a, 0x38, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61,
+	0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_entity_proto_rawDescOnce sync.Once
+	file_fabl_v1_entity_proto_rawDescData = file_fabl_v1_entity_proto_rawDesc
+)
+
+func file_fabl_v1_entity_proto_rawDescGZIP() []byte {
+	file_fabl_v1_entity_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_entity_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_entity_proto_rawDescData)
+	})
+	return file_fabl_v1_entity_proto_rawDescData
+}
+
+var file_fabl_v1_entity_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_fabl_v1_entity_proto_goTypes = []interface{}{
+	(*Entity)(nil),                 // 0: fabl.v1.Entity
+	(*Entity_Connection)(nil),      // 1: fabl.v1.Entity.Connection
+	(*Entity_ConnectionPoint)(nil), // 2: fabl.v1.Entity.ConnectionPoint
+	(*Entity_Connections)(nil),     // 3: fabl.v1.Entity.Connections
+	nil,                            // 4: fabl.v1.Entity.ItemsEntry
+	(*Position)(nil),               // 5: fabl.v1.Position
+	(*_struct.Struct)(nil),         // 6: google.protobuf.Struct
+}
+var file_fabl_v1_entity_proto_depIdxs = []int32{
+	5,  // 0: fabl.v1.Entity.position:type_name -> fabl.v1.Position
+	4,  // 1: fabl.v1.Entity.items:type_name -> fabl.v1.Entity.ItemsEntry
+	6,  // 2: fabl.v1.Entity.control_behavior:type_name -> google.protobuf.Struct
+	3,  // 3: fabl.v1.Entity.connections:type_name -> fabl.v1.Entity.Connections
+	6,  // 4: fabl.v1.Entity.extra:type_name -> google.protobuf.Struct
+	1,  // 5: fabl.v1.Entity.ConnectionPoint.red:type_name -> fabl.v1.Entity.Connection
+	1,  // 6: fabl.v1.Entity.ConnectionPoint.green:type_name -> fabl.v1.Entity.Connection
+	2,  // 7: fabl.v1.Entity.Connections.point_1:type_name -> fabl.v1.Entity.ConnectionPoint
+	2,  // 8: fabl.v1.Entity.Connections.point_2:type_name -> fabl.v1.Entity.ConnectionPoint
+	1,  // 9: fabl.v1.Entity.Connections.cu0:type_name -> fabl.v1.Entity.Connection
+	1,  // 10: fabl.v1.Entity.Connections.cu1:type_name -> fabl.v1.Entity.Connection
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_entity_proto_init() }
+func file_fabl_v1_entity_proto_init() {
+	if File_fabl_v1_entity_proto != nil {
+		return
+	}
+	file_fabl_v1_position_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fabl_v1_entity_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fabl_v1_entity_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity_Connection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fabl_v1_entity_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity_ConnectionPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fabl_v1_entity_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Entity_Connections); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_entity_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_entity_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_entity_proto_depIdxs,
+		MessageInfos:      file_fabl_v1_entity_proto_msgTypes,
+	}.Build()
+	File_fabl_v1_entity_proto = out.File
+	file_fabl_v1_entity_proto_rawDesc = nil
+	file_fabl_v1_entity_proto_goTypes = nil
+	file_fabl_v1_entity_proto_depIdxs = nil
+}
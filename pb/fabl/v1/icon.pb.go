@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/icon.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Icon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index  uint32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Signal *SignalID `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
+}
+
+func (x *Icon) Reset() {
+	*x = Icon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_icon_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Icon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Icon) ProtoMessage() {}
+
+func (x *Icon) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_icon_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Icon.ProtoReflect.Descriptor instead.
+func (*Icon) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_icon_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Icon) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *Icon) GetSignal() *SignalID {
+	if x != nil {
+		return x.Signal
+	}
+	return nil
+}
+
+var File_fabl_v1_icon_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_icon_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x63, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x66,
+	0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x47, 0x0a, 0x04, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x42,
+	0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f,
+	0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_icon_proto_rawDescOnce sync.Once
+	file_fabl_v1_icon_proto_rawDescData = file_fabl_v1_icon_proto_rawDesc
+)
+
+func file_fabl_v1_icon_proto_rawDescGZIP() []byte {
+	file_fabl_v1_icon_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_icon_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_icon_proto_rawDescData)
+	})
+	return file_fabl_v1_icon_proto_rawDescData
+}
+
+var file_fabl_v1_icon_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fabl_v1_icon_proto_goTypes = []interface{}{
+	(*Icon)(nil),     // 0: fabl.v1.Icon
+	(*SignalID)(nil), // 1: fabl.v1.SignalID
+}
+var file_fabl_v1_icon_proto_depIdxs = []int32{
+	1, // 0: fabl.v1.Icon.signal:type_name -> fabl.v1.SignalID
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_icon_proto_init() }
+func file_fabl_v1_icon_proto_init() {
+	if File_fabl_v1_icon_proto != nil {
+		return
+	}
+	file_fabl_v1_signal_id_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fabl_v1_icon_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Icon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_icon_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_icon_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_icon_proto_depIdxs,
+		MessageInfos:      file_fabl_v1_icon_proto_msgTypes,
+	}.Build()
+	File_fabl_v1_icon_proto = out.File
+	file_fabl_v1_icon_proto_rawDesc = nil
+	file_fabl_v1_icon_proto_goTypes = nil
+	file_fabl_v1_icon_proto_depIdxs = nil
+}
@@ -34,6 +34,8 @@ type Item struct {
 	// Types that are assignable to Item:
 	//	*Item_Blueprint
 	//	*Item_BlueprintBook
+	//	*Item_DeconstructionPlanner
+	//	*Item_UpgradePlanner
 	Item isItem_Item `protobuf_oneof:"item"`
 }
 
@@ -97,6 +99,20 @@ func (x *Item) GetBlueprintBook() *BlueprintBook {
 	return nil
 }
 
+func (x *Item) GetDeconstructionPlanner() *DeconstructionPlanner {
+	if x, ok := x.GetItem().(*Item_DeconstructionPlanner); ok {
+		return x.DeconstructionPlanner
+	}
+	return nil
+}
+
+func (x *Item) GetUpgradePlanner() *UpgradePlanner {
+	if x, ok := x.GetItem().(*Item_UpgradePlanner); ok {
+		return x.UpgradePlanner
+	}
+	return nil
+}
+
 type isItem_Item interface {
 	isItem_Item()
 }
@@ -109,10 +125,22 @@ type Item_BlueprintBook struct {
 	BlueprintBook *BlueprintBook `protobuf:"bytes,3,opt,name=blueprint_book,json=blueprintBook,proto3,oneof"`
 }
 
+type Item_DeconstructionPlanner struct {
+	DeconstructionPlanner *DeconstructionPlanner `protobuf:"bytes,4,opt,name=deconstruction_planner,json=deconstructionPlanner,proto3,oneof"`
+}
+
+type Item_UpgradePlanner struct {
+	UpgradePlanner *UpgradePlanner `protobuf:"bytes,5,opt,name=upgrade_planner,json=upgradePlanner,proto3,oneof"`
+}
+
 func (*Item_Blueprint) isItem_Item() {}
 
 func (*Item_BlueprintBook) isItem_Item() {}
 
+func (*Item_DeconstructionPlanner) isItem_Item() {}
+
+func (*Item_UpgradePlanner) isItem_Item() {}
+
 var File_fabl_v1_item_proto protoreflect.FileDescriptor
 
 var file_fabl_v1_item_proto_rawDesc = []byte{
@@ -121,20 +149,34 @@ var file_fabl_v1_item_proto_rawDesc = []byte{
 	0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f,
 	0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x23, 0x0a,
-	0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6c, 0x75,
-	0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
-	0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72,
-	0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x42,
-	0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f,
-	0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x66, 0x61, 0x62, 0x6c,
+	0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x02, 0x0a, 0x04, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x61, 0x62,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x09, 0x62, 0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x62,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x62,
+	0x6c, 0x75, 0x65, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x57, 0x0a, 0x16,
+	0x64, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66,
+	0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x15,
+	0x64, 0x65, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6c,
+	0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70,
+	0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -151,18 +193,22 @@ func file_fabl_v1_item_proto_rawDescGZIP() []byte {
 
 var file_fabl_v1_item_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_fabl_v1_item_proto_goTypes = []interface{}{
-	(*Item)(nil),          // 0: fabl.v1.Item
-	(*Blueprint)(nil),     // 1: fabl.v1.Blueprint
-	(*BlueprintBook)(nil), // 2: fabl.v1.BlueprintBook
+	(*Item)(nil),                  // 0: fabl.v1.Item
+	(*Blueprint)(nil),             // 1: fabl.v1.Blueprint
+	(*BlueprintBook)(nil),         // 2: fabl.v1.BlueprintBook
+	(*DeconstructionPlanner)(nil), // 3: fabl.v1.DeconstructionPlanner
+	(*UpgradePlanner)(nil),        // 4: fabl.v1.UpgradePlanner
 }
 var file_fabl_v1_item_proto_depIdxs = []int32{
 	1, // 0: fabl.v1.Item.blueprint:type_name -> fabl.v1.Blueprint
 	2, // 1: fabl.v1.Item.blueprint_book:type_name -> fabl.v1.BlueprintBook
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: fabl.v1.Item.deconstruction_planner:type_name -> fabl.v1.DeconstructionPlanner
+	4, // 3: fabl.v1.Item.upgrade_planner:type_name -> fabl.v1.UpgradePlanner
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_fabl_v1_item_proto_init() }
@@ -172,6 +218,8 @@ func file_fabl_v1_item_proto_init() {
 	}
 	file_fabl_v1_blueprint_proto_init()
 	file_fabl_v1_blueprint_book_proto_init()
+	file_fabl_v1_deconstruction_planner_proto_init()
+	file_fabl_v1_upgrade_planner_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_fabl_v1_item_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Item); i {
@@ -189,6 +237,8 @@ func file_fabl_v1_item_proto_init() {
 	file_fabl_v1_item_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Item_Blueprint)(nil),
 		(*Item_BlueprintBook)(nil),
+		(*Item_DeconstructionPlanner)(nil),
+		(*Item_UpgradePlanner)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/item_kind.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type ItemKind int32
+
+const (
+	ItemKind_ITEM_KIND_UNSPECIFIED            ItemKind = 0
+	ItemKind_ITEM_KIND_BLUEPRINT              ItemKind = 1
+	ItemKind_ITEM_KIND_BLUEPRINT_BOOK         ItemKind = 2
+	ItemKind_ITEM_KIND_DECONSTRUCTION_PLANNER ItemKind = 3
+	ItemKind_ITEM_KIND_UPGRADE_PLANNER        ItemKind = 4
+)
+
+// Enum value maps for ItemKind.
+var (
+	ItemKind_name = map[int32]string{
+		0: "ITEM_KIND_UNSPECIFIED",
+		1: "ITEM_KIND_BLUEPRINT",
+		2: "ITEM_KIND_BLUEPRINT_BOOK",
+		3: "ITEM_KIND_DECONSTRUCTION_PLANNER",
+		4: "ITEM_KIND_UPGRADE_PLANNER",
+	}
+	ItemKind_value = map[string]int32{
+		"ITEM_KIND_UNSPECIFIED":            0,
+		"ITEM_KIND_BLUEPRINT":              1,
+		"ITEM_KIND_BLUEPRINT_BOOK":         2,
+		"ITEM_KIND_DECONSTRUCTION_PLANNER": 3,
+		"ITEM_KIND_UPGRADE_PLANNER":        4,
+	}
+)
+
+func (x ItemKind) Enum() *ItemKind {
+	p := new(ItemKind)
+	*p = x
+	return p
+}
+
+func (x ItemKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_fabl_v1_item_kind_proto_enumTypes[0].Descriptor()
+}
+
+func (ItemKind) Type() protoreflect.EnumType {
+	return &file_fabl_v1_item_kind_proto_enumTypes[0]
+}
+
+func (x ItemKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemKind.Descriptor instead.
+func (ItemKind) EnumDescriptor() ([]byte, []int) {
+	return file_fabl_v1_item_kind_proto_rawDescGZIP(), []int{0}
+}
+
+var File_fabl_v1_item_kind_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_item_kind_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6b,
+	0x69, 0x6e, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e,
+	0x76, 0x31, 0x2a, 0xa1, 0x01, 0x0a, 0x08, 0x49, 0x74, 0x65, 0x6d, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x19, 0x0a, 0x15, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x4c, 0x55, 0x45, 0x50, 0x52, 0x49, 0x4e,
+	0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x42, 0x4c, 0x55, 0x45, 0x50, 0x52, 0x49, 0x4e, 0x54, 0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x10,
+	0x02, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x44,
+	0x45, 0x43, 0x4f, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c,
+	0x41, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x54, 0x45, 0x4d, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x50, 0x4c, 0x41,
+	0x4e, 0x4e, 0x45, 0x52, 0x10, 0x04, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69, 0x2e, 0x66, 0x61,
+	0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76,
+	0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_item_kind_proto_rawDescOnce sync.Once
+	file_fabl_v1_item_kind_proto_rawDescData = file_fabl_v1_item_kind_proto_rawDesc
+)
+
+func file_fabl_v1_item_kind_proto_rawDescGZIP() []byte {
+	file_fabl_v1_item_kind_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_item_kind_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_item_kind_proto_rawDescData)
+	})
+	return file_fabl_v1_item_kind_proto_rawDescData
+}
+
+var file_fabl_v1_item_kind_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_fabl_v1_item_kind_proto_goTypes = []interface{}{
+	(ItemKind)(0), // 0: fabl.v1.ItemKind
+}
+var file_fabl_v1_item_kind_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_item_kind_proto_init() }
+func file_fabl_v1_item_kind_proto_init() {
+	if File_fabl_v1_item_kind_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_item_kind_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_item_kind_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_item_kind_proto_depIdxs,
+		EnumInfos:         file_fabl_v1_item_kind_proto_enumTypes,
+	}.Build()
+	File_fabl_v1_item_kind_proto = out.File
+	file_fabl_v1_item_kind_proto_rawDesc = nil
+	file_fabl_v1_item_kind_proto_goTypes = nil
+	file_fabl_v1_item_kind_proto_depIdxs = nil
+}
@@ -0,0 +1,341 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.13.0
+// source: fabl/v1/upgrade_planner.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	_struct "github.com/golang/protobuf/ptypes/struct"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type UpgradePlanner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version     uint64                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Item        string                   `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	Label       string                   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Icons       []*Icon                  `protobuf:"bytes,5,rep,name=icons,proto3" json:"icons,omitempty"`
+	Description string                   `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Mappers     []*UpgradePlanner_Mapper `protobuf:"bytes,9,rep,name=mappers,proto3" json:"mappers,omitempty"`
+	// Decoded from version, ignored when encoding.
+	GameVersion *GameVersion `protobuf:"bytes,10,opt,name=game_version,json=gameVersion,proto3" json:"game_version,omitempty"`
+	// All other properties, as found in the import string.
+	Extra *_struct.Struct `protobuf:"bytes,15,opt,name=extra,proto3" json:"extra,omitempty"`
+	// All other settings, as found in the import string.
+	SettingsExtra *_struct.Struct `protobuf:"bytes,16,opt,name=settings_extra,json=settingsExtra,proto3" json:"settings_extra,omitempty"`
+}
+
+func (x *UpgradePlanner) Reset() {
+	*x = UpgradePlanner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_upgrade_planner_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradePlanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePlanner) ProtoMessage() {}
+
+func (x *UpgradePlanner) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_upgrade_planner_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePlanner.ProtoReflect.Descriptor instead.
+func (*UpgradePlanner) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_upgrade_planner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpgradePlanner) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpgradePlanner) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *UpgradePlanner) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *UpgradePlanner) GetIcons() []*Icon {
+	if x != nil {
+		return x.Icons
+	}
+	return nil
+}
+
+func (x *UpgradePlanner) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpgradePlanner) GetMappers() []*UpgradePlanner_Mapper {
+	if x != nil {
+		return x.Mappers
+	}
+	return nil
+}
+
+func (x *UpgradePlanner) GetGameVersion() *GameVersion {
+	if x != nil {
+		return x.GameVersion
+	}
+	return nil
+}
+
+func (x *UpgradePlanner) GetExtra() *_struct.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *UpgradePlanner) GetSettingsExtra() *_struct.Struct {
+	if x != nil {
+		return x.SettingsExtra
+	}
+	return nil
+}
+
+type UpgradePlanner_Mapper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	From  *SignalID `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To    *SignalID `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *UpgradePlanner_Mapper) Reset() {
+	*x = UpgradePlanner_Mapper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fabl_v1_upgrade_planner_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradePlanner_Mapper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePlanner_Mapper) ProtoMessage() {}
+
+func (x *UpgradePlanner_Mapper) ProtoReflect() protoreflect.Message {
+	mi := &file_fabl_v1_upgrade_planner_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePlanner_Mapper.ProtoReflect.Descriptor instead.
+func (*UpgradePlanner_Mapper) Descriptor() ([]byte, []int) {
+	return file_fabl_v1_upgrade_planner_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *UpgradePlanner_Mapper) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *UpgradePlanner_Mapper) GetFrom() *SignalID {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *UpgradePlanner_Mapper) GetTo() *SignalID {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+var File_fabl_v1_upgrade_planner_proto protoreflect.FileDescriptor
+
+var file_fabl_v1_upgrade_planner_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x07, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x12, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x63, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x66, 0x61, 0x62, 0x6c, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xe7, 0x03, 0x0a, 0x0e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x23, 0x0a, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x63, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x07, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x07,
+	0x6d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x67, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x2d, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x12,
+	0x3e, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x52, 0x0d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x78, 0x74, 0x72, 0x61, 0x1a,
+	0x68, 0x0a, 0x06, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x25, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x49, 0x44,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x49, 0x44, 0x52, 0x02, 0x74, 0x6f, 0x42, 0x1c, 0x5a, 0x1a, 0x61, 0x70, 0x69,
+	0x2e, 0x66, 0x61, 0x62, 0x6c, 0x2e, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x61, 0x62,
+	0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_fabl_v1_upgrade_planner_proto_rawDescOnce sync.Once
+	file_fabl_v1_upgrade_planner_proto_rawDescData = file_fabl_v1_upgrade_planner_proto_rawDesc
+)
+
+func file_fabl_v1_upgrade_planner_proto_rawDescGZIP() []byte {
+	file_fabl_v1_upgrade_planner_proto_rawDescOnce.Do(func() {
+		file_fabl_v1_upgrade_planner_proto_rawDescData = protoimpl.X.CompressGZIP(file_fabl_v1_upgrade_planner_proto_rawDescData)
+	})
+	return file_fabl_v1_upgrade_planner_proto_rawDescData
+}
+
+var file_fabl_v1_upgrade_planner_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fabl_v1_upgrade_planner_proto_goTypes = []interface{}{
+	(*UpgradePlanner)(nil),        // 0: fabl.v1.UpgradePlanner
+	(*UpgradePlanner_Mapper)(nil), // 1: fabl.v1.UpgradePlanner.Mapper
+	(*Icon)(nil),                  // 2: fabl.v1.Icon
+	(*GameVersion)(nil),           // 3: fabl.v1.GameVersion
+	(*_struct.Struct)(nil),        // 4: google.protobuf.Struct
+	(*SignalID)(nil),              // 5: fabl.v1.SignalID
+}
+var file_fabl_v1_upgrade_planner_proto_depIdxs = []int32{
+	2, // 0: fabl.v1.UpgradePlanner.icons:type_name -> fabl.v1.Icon
+	1, // 1: fabl.v1.UpgradePlanner.mappers:type_name -> fabl.v1.UpgradePlanner.Mapper
+	3, // 2: fabl.v1.UpgradePlanner.game_version:type_name -> fabl.v1.GameVersion
+	4, // 3: fabl.v1.UpgradePlanner.extra:type_name -> google.protobuf.Struct
+	4, // 4: fabl.v1.UpgradePlanner.settings_extra:type_name -> google.protobuf.Struct
+	5, // 5: fabl.v1.UpgradePlanner.Mapper.from:type_name -> fabl.v1.SignalID
+	5, // 6: fabl.v1.UpgradePlanner.Mapper.to:type_name -> fabl.v1.SignalID
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_fabl_v1_upgrade_planner_proto_init() }
+func file_fabl_v1_upgrade_planner_proto_init() {
+	if File_fabl_v1_upgrade_planner_proto != nil {
+		return
+	}
+	file_fabl_v1_game_version_proto_init()
+	file_fabl_v1_icon_proto_init()
+	file_fabl_v1_signal_id_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_fabl_v1_upgrade_planner_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePlanner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fabl_v1_upgrade_planner_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePlanner_Mapper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_fabl_v1_upgrade_planner_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fabl_v1_upgrade_planner_proto_goTypes,
+		DependencyIndexes: file_fabl_v1_upgrade_planner_proto_depIdxs,
+		MessageInfos:      file_fabl_v1_upgrade_planner_proto_msgTypes,
+	}.Build()
+	File_fabl_v1_upgrade_planner_proto = out.File
+	file_fabl_v1_upgrade_planner_proto_rawDesc = nil
+	file_fabl_v1_upgrade_planner_proto_goTypes = nil
+	file_fabl_v1_upgrade_planner_proto_depIdxs = nil
+}